@@ -5,13 +5,15 @@ package appautoscaling
 
 // Exports for use in tests only.
 var (
-	ResourcePolicy          = resourcePolicy
-	ResourceScheduledAction = resourceScheduledAction
-	ResourceTarget          = resourceTarget
+	ResourcePolicy           = resourcePolicy
+	ResourceScheduledAction  = resourceScheduledAction
+	ResourceScheduledActions = resourceScheduledActions
+	ResourceTarget           = resourceTarget
 
-	FindScalingPolicyByFourPartKey   = findScalingPolicyByFourPartKey
-	FindScheduledActionByFourPartKey = findScheduledActionByFourPartKey
-	FindTargetByThreePartKey         = findTargetByThreePartKey
+	FindScalingPolicyByFourPartKey     = findScalingPolicyByFourPartKey
+	FindScheduledActionByFourPartKey   = findScheduledActionByFourPartKey
+	FindScheduledActionsByThreePartKey = findScheduledActionsByThreePartKey
+	FindTargetByThreePartKey           = findTargetByThreePartKey
 
 	PolicyParseImportID = policyParseImportID
 )
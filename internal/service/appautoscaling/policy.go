@@ -38,6 +38,8 @@ func resourcePolicy() *schema.Resource {
 			StateContext: resourcePolicyImport,
 		},
 
+		CustomizeDiff: validateScalableDimensionServiceNamespace,
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"alarm_arns": {
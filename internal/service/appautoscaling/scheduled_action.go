@@ -34,6 +34,8 @@ func resourceScheduledAction() *schema.Resource {
 		UpdateWithoutTimeout: resourceScheduledActionPut,
 		DeleteWithoutTimeout: resourceScheduledActionDelete,
 
+		CustomizeDiff: validateScalableDimensionServiceNamespace,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appautoscaling
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	scheduledActionsResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_appautoscaling_scheduled_actions", name="Scheduled Actions")
+func resourceScheduledActions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScheduledActionsCreate,
+		ReadWithoutTimeout:   resourceScheduledActionsRead,
+		UpdateWithoutTimeout: resourceScheduledActionsUpdate,
+		DeleteWithoutTimeout: resourceScheduledActionsDelete,
+
+		CustomizeDiff: validateScalableDimensionServiceNamespace,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrResourceID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scalable_dimension": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scheduled_action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						names.AttrMaxCapacity: {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"min_capacity": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrSchedule: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrStartTime: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UTC",
+						},
+					},
+				},
+			},
+			"service_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceScheduledActionsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	serviceNamespace, resourceID, scalableDimension := d.Get("service_namespace").(string), d.Get(names.AttrResourceID).(string), d.Get("scalable_dimension").(string)
+	id, _ := flex.FlattenResourceId([]string{serviceNamespace, resourceID, scalableDimension}, scheduledActionsResourceIDPartCount, false)
+
+	for _, tfMapRaw := range d.Get("scheduled_action").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		input := expandPutScheduledActionInput(tfMap, serviceNamespace, resourceID, scalableDimension)
+
+		if err := putScheduledAction(ctx, conn, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Application Auto Scaling Scheduled Actions (%s): putting Scheduled Action (%s): %s", id, aws.ToString(input.ScheduledActionName), err)
+		}
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceScheduledActionsRead(ctx, d, meta)...)
+}
+
+func resourceScheduledActionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	serviceNamespace, resourceID, scalableDimension := d.Get("service_namespace").(string), d.Get(names.AttrResourceID).(string), d.Get("scalable_dimension").(string)
+
+	var actionNames []string
+	for _, tfMapRaw := range d.Get("scheduled_action").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]any); ok {
+			actionNames = append(actionNames, tfMap[names.AttrName].(string))
+		}
+	}
+
+	scheduledActions, err := findScheduledActionsByThreePartKey(ctx, conn, serviceNamespace, resourceID, scalableDimension, actionNames)
+
+	if err == nil && len(scheduledActions) == 0 {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Auto Scaling Scheduled Actions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Application Auto Scaling Scheduled Actions (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("scheduled_action", flattenScheduledActions(scheduledActions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scheduled_action: %s", err)
+	}
+
+	return diags
+}
+
+func resourceScheduledActionsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	serviceNamespace, resourceID, scalableDimension := d.Get("service_namespace").(string), d.Get(names.AttrResourceID).(string), d.Get("scalable_dimension").(string)
+
+	if d.HasChange("scheduled_action") {
+		o, n := d.GetChange("scheduled_action")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		newNames := make(map[string]struct{})
+		for _, tfMapRaw := range ns.List() {
+			if tfMap, ok := tfMapRaw.(map[string]any); ok {
+				newNames[tfMap[names.AttrName].(string)] = struct{}{}
+			}
+		}
+
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name := tfMap[names.AttrName].(string)
+			if _, ok := newNames[name]; ok {
+				// Modified in place below.
+				continue
+			}
+
+			if err := deleteScheduledAction(ctx, conn, serviceNamespace, resourceID, scalableDimension, name); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Application Auto Scaling Scheduled Actions (%s): deleting Scheduled Action (%s): %s", d.Id(), name, err)
+			}
+		}
+
+		for _, tfMapRaw := range ns.Difference(os).List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			input := expandPutScheduledActionInput(tfMap, serviceNamespace, resourceID, scalableDimension)
+
+			if err := putScheduledAction(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Application Auto Scaling Scheduled Actions (%s): putting Scheduled Action (%s): %s", d.Id(), aws.ToString(input.ScheduledActionName), err)
+			}
+		}
+	}
+
+	return append(diags, resourceScheduledActionsRead(ctx, d, meta)...)
+}
+
+func resourceScheduledActionsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	serviceNamespace, resourceID, scalableDimension := d.Get("service_namespace").(string), d.Get(names.AttrResourceID).(string), d.Get("scalable_dimension").(string)
+
+	log.Printf("[DEBUG] Deleting Application Auto Scaling Scheduled Actions: %s", d.Id())
+	for _, tfMapRaw := range d.Get("scheduled_action").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if err := deleteScheduledAction(ctx, conn, serviceNamespace, resourceID, scalableDimension, name); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Application Auto Scaling Scheduled Actions (%s): deleting Scheduled Action (%s): %s", d.Id(), name, err)
+		}
+	}
+
+	return diags
+}
+
+func putScheduledAction(ctx context.Context, conn *applicationautoscaling.Client, input *applicationautoscaling.PutScheduledActionInput) error {
+	const (
+		timeout = 5 * time.Minute
+	)
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.ObjectNotFoundException](ctx, timeout, func(ctx context.Context) (any, error) {
+		return conn.PutScheduledAction(ctx, input)
+	})
+
+	return err
+}
+
+func deleteScheduledAction(ctx context.Context, conn *applicationautoscaling.Client, serviceNamespace, resourceID, scalableDimension, name string) error {
+	input := applicationautoscaling.DeleteScheduledActionInput{
+		ResourceId:          aws.String(resourceID),
+		ScalableDimension:   awstypes.ScalableDimension(scalableDimension),
+		ScheduledActionName: aws.String(name),
+		ServiceNamespace:    awstypes.ServiceNamespace(serviceNamespace),
+	}
+	_, err := conn.DeleteScheduledAction(ctx, &input)
+
+	if errs.IsA[*awstypes.ObjectNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findScheduledActionsByThreePartKey(ctx context.Context, conn *applicationautoscaling.Client, serviceNamespace, resourceID, scalableDimension string, actionNames []string) ([]awstypes.ScheduledAction, error) {
+	if len(actionNames) == 0 {
+		return nil, nil
+	}
+
+	input := applicationautoscaling.DescribeScheduledActionsInput{
+		ResourceId:           aws.String(resourceID),
+		ScalableDimension:    awstypes.ScalableDimension(scalableDimension),
+		ScheduledActionNames: actionNames,
+		ServiceNamespace:     awstypes.ServiceNamespace(serviceNamespace),
+	}
+
+	return findScheduledActions(ctx, conn, &input, func(v awstypes.ScheduledAction) bool {
+		return string(v.ScalableDimension) == scalableDimension
+	})
+}
+
+func expandPutScheduledActionInput(tfMap map[string]any, serviceNamespace, resourceID, scalableDimension string) *applicationautoscaling.PutScheduledActionInput {
+	input := &applicationautoscaling.PutScheduledActionInput{
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: awstypes.ScalableDimension(scalableDimension),
+		ScalableTargetAction: expandScalableTargetAction([]any{map[string]any{
+			names.AttrMaxCapacity: tfMap[names.AttrMaxCapacity],
+			"min_capacity":        tfMap["min_capacity"],
+		}}),
+		Schedule:            aws.String(tfMap[names.AttrSchedule].(string)),
+		ScheduledActionName: aws.String(tfMap[names.AttrName].(string)),
+		ServiceNamespace:    awstypes.ServiceNamespace(serviceNamespace),
+		Timezone:            aws.String(tfMap["timezone"].(string)),
+	}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		input.EndTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap[names.AttrStartTime].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		input.StartTime = aws.Time(t)
+	}
+
+	return input
+}
+
+func flattenScheduledActions(apiObjects []awstypes.ScheduledAction) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrName:     aws.ToString(apiObject.ScheduledActionName),
+			names.AttrSchedule: aws.ToString(apiObject.Schedule),
+			"timezone":         aws.ToString(apiObject.Timezone),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = v.Format(time.RFC3339)
+		}
+
+		if v := apiObject.ScalableTargetAction; v != nil {
+			if v.MaxCapacity != nil {
+				tfMap[names.AttrMaxCapacity] = flex.Int32ToStringValue(v.MaxCapacity)
+			}
+			if v.MinCapacity != nil {
+				tfMap["min_capacity"] = flex.Int32ToStringValue(v.MinCapacity)
+			}
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap[names.AttrStartTime] = v.Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
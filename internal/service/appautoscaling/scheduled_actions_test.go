@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appautoscaling_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppAutoScalingScheduledActions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	schedule := time.Now().AddDate(0, 0, 1).Format("2006-01-02T15:04:05")
+	resourceName := "aws_appautoscaling_scheduled_actions.test"
+	autoscalingTargetResourceName := "aws_appautoscaling_target.test"
+	actionNames := []string{rName + "-scale-up", rName + "-scale-down", rName + "-one-off"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionsDestroy(ctx, actionNames),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledActionsExists(ctx, resourceName, actionNames[:2], 2),
+					resource.TestCheckResourceAttrPair(resourceName, "service_namespace", autoscalingTargetResourceName, "service_namespace"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceID, autoscalingTargetResourceName, names.AttrResourceID),
+					resource.TestCheckResourceAttrPair(resourceName, "scalable_dimension", autoscalingTargetResourceName, "scalable_dimension"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						names.AttrName:        actionNames[0],
+						names.AttrSchedule:    "cron(0 8 ? * MON-FRI *)",
+						"timezone":            "America/New_York",
+						"min_capacity":        "5",
+						names.AttrMaxCapacity: "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						names.AttrName:        actionNames[1],
+						names.AttrSchedule:    "cron(0 20 ? * MON-FRI *)",
+						"timezone":            "America/New_York",
+						"min_capacity":        "1",
+						names.AttrMaxCapacity: "5",
+					}),
+				),
+			},
+			{
+				Config: testAccScheduledActionsConfig_updated(rName, schedule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledActionsExists(ctx, resourceName, actionNames, 2),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						names.AttrName:        actionNames[0],
+						names.AttrSchedule:    "cron(0 7 ? * MON-FRI *)",
+						"timezone":            "Europe/London",
+						"min_capacity":        "6",
+						names.AttrMaxCapacity: "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						names.AttrName:        actionNames[2],
+						names.AttrSchedule:    fmt.Sprintf("at(%s)", schedule),
+						"timezone":            "UTC",
+						"min_capacity":        "2",
+						names.AttrMaxCapacity: "8",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppAutoScalingScheduledActions_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appautoscaling_scheduled_actions.test"
+	actionNames := []string{rName + "-scale-up", rName + "-scale-down"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionsDestroy(ctx, actionNames),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledActionsExists(ctx, resourceName, actionNames, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappautoscaling.ResourceScheduledActions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledActionsDestroy(ctx context.Context, actionNames []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appautoscaling_scheduled_actions" {
+				continue
+			}
+
+			output, err := tfappautoscaling.FindScheduledActionsByThreePartKey(ctx, conn, rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes[names.AttrResourceID], rs.Primary.Attributes["scalable_dimension"], actionNames)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("Application Auto Scaling Scheduled Actions %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScheduledActionsExists(ctx context.Context, n string, actionNames []string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+		output, err := tfappautoscaling.FindScheduledActionsByThreePartKey(ctx, conn, rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes[names.AttrResourceID], rs.Primary.Attributes["scalable_dimension"], actionNames)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != count {
+			return fmt.Errorf("Application Auto Scaling Scheduled Actions %s: expected %d scheduled actions, got %d", rs.Primary.ID, count, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccScheduledActionsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.test.name}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  min_capacity       = 1
+  max_capacity       = 10
+}
+
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "UserID"
+
+  attribute {
+    name = "UserID"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccScheduledActionsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccScheduledActionsConfig_base(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_scheduled_actions" "test" {
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+
+  scheduled_action {
+    name         = "%[1]s-scale-up"
+    schedule     = "cron(0 8 ? * MON-FRI *)"
+    timezone     = "America/New_York"
+    min_capacity = 5
+    max_capacity = 10
+  }
+
+  scheduled_action {
+    name         = "%[1]s-scale-down"
+    schedule     = "cron(0 20 ? * MON-FRI *)"
+    timezone     = "America/New_York"
+    min_capacity = 1
+    max_capacity = 5
+  }
+}
+`, rName))
+}
+
+func testAccScheduledActionsConfig_updated(rName, ts string) string {
+	return acctest.ConfigCompose(testAccScheduledActionsConfig_base(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_scheduled_actions" "test" {
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+
+  scheduled_action {
+    name         = "%[1]s-scale-up"
+    schedule     = "cron(0 7 ? * MON-FRI *)"
+    timezone     = "Europe/London"
+    min_capacity = 6
+    max_capacity = 10
+  }
+
+  scheduled_action {
+    name         = "%[1]s-one-off"
+    schedule     = "at(%[2]s)"
+    min_capacity = 2
+    max_capacity = 8
+  }
+}
+`, rName, ts))
+}
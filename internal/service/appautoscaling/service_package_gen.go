@@ -43,6 +43,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Scheduled Action",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceScheduledActions,
+			TypeName: "aws_appautoscaling_scheduled_actions",
+			Name:     "Scheduled Actions",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceTarget,
 			TypeName: "aws_appautoscaling_target",
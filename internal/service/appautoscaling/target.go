@@ -40,6 +40,8 @@ func resourceTarget() *schema.Resource {
 			StateContext: resourceTargetImport,
 		},
 
+		CustomizeDiff: validateScalableDimensionServiceNamespace,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -292,6 +294,25 @@ func resourceTargetImport(ctx context.Context, d *schema.ResourceData, meta any)
 	return []*schema.ResourceData{d}, nil
 }
 
+// validateScalableDimensionServiceNamespace verifies at plan time that the
+// scalable dimension belongs to the service namespace, e.g. "ecs:service:DesiredCount" and "ecs".
+func validateScalableDimensionServiceNamespace(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("scalable_dimension") || !d.NewValueKnown("service_namespace") {
+		return nil
+	}
+
+	dimension, namespace := d.Get("scalable_dimension").(string), d.Get("service_namespace").(string)
+	if dimension == "" || namespace == "" {
+		return nil
+	}
+
+	if prefix, _, _ := strings.Cut(dimension, ":"); prefix != namespace {
+		return fmt.Errorf("scalable_dimension (%s) is not valid for service_namespace (%s), expected a dimension beginning with %q", dimension, namespace, namespace+":")
+	}
+
+	return nil
+}
+
 func registerScalableTarget(ctx context.Context, conn *applicationautoscaling.Client, input *applicationautoscaling.RegisterScalableTargetInput) error {
 	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func(ctx context.Context) (any, error) {
@@ -243,6 +243,23 @@ func TestAccAppAutoScalingTarget_suspendedState(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingTarget_scalableDimensionMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetConfig_scalableDimensionMismatch(),
+				ExpectError: regexache.MustCompile(`scalable_dimension \(ecs:service:DesiredCount\) is not valid for service_namespace \(dynamodb\)`),
+			},
+		},
+	})
+}
+
 func TestAccAppAutoScalingTarget_suspendedState_maintainsExisting(t *testing.T) {
 	ctx := acctest.Context(t)
 	var readTarget awstypes.ScalableTarget
@@ -383,6 +400,18 @@ resource "aws_ecs_service" "test" {
 `, rName, serviceDesiredCount)
 }
 
+func testAccTargetConfig_scalableDimensionMismatch() string {
+	return `
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/example"
+  scalable_dimension = "ecs:service:DesiredCount"
+  min_capacity       = 1
+  max_capacity       = 10
+}
+`
+}
+
 func testAccTargetConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccTargetConfig_baseECS(rName, 1), `
 resource "aws_appautoscaling_target" "test" {
@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appflow"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appflow/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_appflow_connector", name="Connector")
+func newConnectorResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &connectorResource{}, nil
+}
+
+type connectorResource struct {
+	framework.ResourceWithModel[connectorResourceModel]
+	framework.WithImportByID
+}
+
+func (r *connectorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:           framework.ARNAttributeComputedOnly(),
+			"authentication_config": framework.ResourceComputedListOfObjectsAttribute[authenticationConfigModel](ctx),
+			"connector_label": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z][\w!@#.-]+$`), "must start with an alphanumeric character and contain only alphanumeric characters, underscores and !@#.-"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connector_provisioning_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConnectorProvisioningType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connector_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"connector_provisioning_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[connectorProvisioningConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"lambda": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaConnectorProvisioningConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"lambda_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *connectorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data connectorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFlowClient(ctx)
+
+	label := fwflex.StringValueFromFramework(ctx, data.ConnectorLabel)
+	var input appflow.RegisterConnectorInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	_, err := conn.RegisterConnector(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("registering AppFlow Connector (%s)", label), err.Error())
+
+		return
+	}
+
+	output, err := findConnectorByLabel(ctx, conn, label)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppFlow Connector (%s)", label), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, label)
+	response.Diagnostics.Append(r.flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *connectorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data connectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFlowClient(ctx)
+
+	label := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findConnectorByLabel(ctx, conn, label)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppFlow Connector (%s)", label), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *connectorResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new connectorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFlowClient(ctx)
+
+	label := fwflex.StringValueFromFramework(ctx, new.ID)
+	var input appflow.UpdateConnectorRegistrationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	_, err := conn.UpdateConnectorRegistration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating AppFlow Connector (%s)", label), err.Error())
+
+		return
+	}
+
+	// The connector's configuration is re-read from the Lambda function on update.
+	output, err := findConnectorByLabel(ctx, conn, label)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppFlow Connector (%s)", label), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *connectorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data connectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFlowClient(ctx)
+
+	label := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := appflow.UnregisterConnectorInput{
+		ConnectorLabel: aws.String(label),
+	}
+	_, err := conn.UnregisterConnector(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("unregistering AppFlow Connector (%s)", label), err.Error())
+
+		return
+	}
+}
+
+func (r *connectorResource) flatten(ctx context.Context, connector *awstypes.ConnectorConfiguration, data *connectorResourceModel) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, connector, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Description = fwflex.StringToFramework(ctx, connector.ConnectorDescription)
+
+	return diags
+}
+
+func findConnectorByLabel(ctx context.Context, conn *appflow.Client, label string) (*awstypes.ConnectorConfiguration, error) {
+	input := appflow.DescribeConnectorInput{
+		ConnectorLabel: aws.String(label),
+		ConnectorType:  awstypes.ConnectorTypeCustomconnector,
+	}
+	output, err := conn.DescribeConnector(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConnectorConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConnectorConfiguration, nil
+}
+
+type connectorResourceModel struct {
+	framework.WithRegionModel
+	AuthenticationConfig        fwtypes.ListNestedObjectValueOf[authenticationConfigModel]        `tfsdk:"authentication_config"`
+	ConnectorARN                types.String                                                      `tfsdk:"arn"`
+	ConnectorLabel              types.String                                                      `tfsdk:"connector_label"`
+	ConnectorProvisioningConfig fwtypes.ListNestedObjectValueOf[connectorProvisioningConfigModel] `tfsdk:"connector_provisioning_config"`
+	ConnectorProvisioningType   fwtypes.StringEnum[awstypes.ConnectorProvisioningType]            `tfsdk:"connector_provisioning_type"`
+	ConnectorVersion            types.String                                                      `tfsdk:"connector_version"`
+	Description                 types.String                                                      `tfsdk:"description"`
+	ID                          types.String                                                      `tfsdk:"id"`
+}
+
+type connectorProvisioningConfigModel struct {
+	Lambda fwtypes.ListNestedObjectValueOf[lambdaConnectorProvisioningConfigModel] `tfsdk:"lambda"`
+}
+
+type lambdaConnectorProvisioningConfigModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type authenticationConfigModel struct {
+	CustomAuthConfig      fwtypes.ListNestedObjectValueOf[customAuthConfigModel] `tfsdk:"custom_auth_config"`
+	IsAPIKeyAuthSupported types.Bool                                             `tfsdk:"is_api_key_auth_supported"`
+	IsBasicAuthSupported  types.Bool                                             `tfsdk:"is_basic_auth_supported"`
+	IsCustomAuthSupported types.Bool                                             `tfsdk:"is_custom_auth_supported"`
+	IsOAuth2Supported     types.Bool                                             `tfsdk:"is_oauth2_supported"`
+}
+
+type customAuthConfigModel struct {
+	AuthParameter            fwtypes.ListNestedObjectValueOf[authParameterModel] `tfsdk:"auth_parameter"`
+	CustomAuthenticationType types.String                                        `tfsdk:"custom_authentication_type"`
+}
+
+type authParameterModel struct {
+	ConnectorSuppliedValues fwtypes.ListOfString `tfsdk:"connector_supplied_values"`
+	Description             types.String         `tfsdk:"description"`
+	IsRequired              types.Bool           `tfsdk:"is_required"`
+	IsSensitiveField        types.Bool           `tfsdk:"is_sensitive_field"`
+	Key                     types.String         `tfsdk:"key"`
+	Label                   types.String         `tfsdk:"label"`
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appflow_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/appflow/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappflow "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The custom connector Lambda function must implement the AppFlow Custom Connector SDK
+// and allow invocation by appflow.amazonaws.com.
+const envVarCustomConnectorLambdaARN = "APPFLOW_CUSTOM_CONNECTOR_LAMBDA_ARN"
+
+func TestAccAppFlowConnector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConnectorConfiguration
+	lambdaARN := acctest.SkipIfEnvVarNotSet(t, envVarCustomConnectorLambdaARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFlowServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, lambdaARN, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "authentication_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_label", rName),
+					resource.TestCheckResourceAttr(resourceName, "connector_provisioning_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_provisioning_config.0.lambda.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_provisioning_config.0.lambda.0.lambda_arn", lambdaARN),
+					resource.TestCheckResourceAttr(resourceName, "connector_provisioning_type", "LAMBDA"),
+					resource.TestCheckResourceAttrSet(resourceName, "connector_version"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorConfig_basic(rName, lambdaARN, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppFlowConnector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConnectorConfiguration
+	lambdaARN := acctest.SkipIfEnvVarNotSet(t, envVarCustomConnectorLambdaARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFlowServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, lambdaARN, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappflow.ResourceConnector, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appflow_connector" {
+				continue
+			}
+
+			_, err := tfappflow.FindConnectorByLabel(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFlow Connector %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConnectorExists(ctx context.Context, n string, v *types.ConnectorConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowClient(ctx)
+
+		output, err := tfappflow.FindConnectorByLabel(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConnectorConfig_basic(rName, lambdaARN, description string) string {
+	return fmt.Sprintf(`
+resource "aws_appflow_connector" "test" {
+  connector_label             = %[1]q
+  connector_provisioning_type = "LAMBDA"
+  description                 = %[3]q
+
+  connector_provisioning_config {
+    lambda {
+      lambda_arn = %[2]q
+    }
+  }
+}
+`, rName, lambdaARN, description)
+}
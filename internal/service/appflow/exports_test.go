@@ -5,9 +5,11 @@ package appflow
 
 // Exports for use in tests only.
 var (
+	ResourceConnector        = newConnectorResource
 	ResourceConnectorProfile = resourceConnectorProfile
 	ResourceFlow             = resourceFlow
 
+	FindConnectorByLabel       = findConnectorByLabel
 	FindConnectorProfileByName = findConnectorProfileByName
 	FindFlowByName             = findFlowByName
 )
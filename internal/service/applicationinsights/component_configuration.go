@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationinsights/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_applicationinsights_component_configuration", name="Component Configuration")
+func resourceComponentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentConfigurationPut,
+		ReadWithoutTimeout:   resourceComponentConfigurationRead,
+		UpdateWithoutTimeout: resourceComponentConfigurationPut,
+		DeleteWithoutTimeout: resourceComponentConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_config_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"component_configuration"},
+			},
+			"component_configuration": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Computed:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				ConflictsWith: []string{"auto_config_enabled"},
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"monitor": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tier": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Tier](),
+			},
+		},
+	}
+}
+
+const (
+	componentConfigurationResourceIDPartCount = 2
+)
+
+func resourceComponentConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	resourceGroupName, componentName := d.Get("resource_group_name").(string), d.Get("component_name").(string)
+	id, err := flex.FlattenResourceId([]string{resourceGroupName, componentName}, componentConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := applicationinsights.UpdateComponentConfigurationInput{
+		ComponentName:     aws.String(componentName),
+		Monitor:           aws.Bool(d.Get("monitor").(bool)),
+		ResourceGroupName: aws.String(resourceGroupName),
+		Tier:              awstypes.Tier(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("auto_config_enabled"); ok {
+		input.AutoConfigEnabled = aws.Bool(v.(bool))
+	} else if v, ok := d.GetOk("component_configuration"); ok && (d.IsNewResource() || d.HasChange("component_configuration")) {
+		// Only send the configuration when it is managed here, so that
+		// AWS-discovered settings are not overwritten on unrelated updates.
+		input.ComponentConfiguration = aws.String(v.(string))
+	}
+
+	_, err = conn.UpdateComponentConfiguration(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ApplicationInsights Component Configuration (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceComponentConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceComponentConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), componentConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resourceGroupName, componentName := parts[0], parts[1]
+	output, err := findComponentConfigurationByTwoPartKey(ctx, conn, resourceGroupName, componentName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ApplicationInsights Component Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ApplicationInsights Component Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("component_configuration", output.ComponentConfiguration)
+	d.Set("component_name", componentName)
+	d.Set("monitor", output.Monitor)
+	d.Set("resource_group_name", resourceGroupName)
+	d.Set("tier", output.Tier)
+
+	return diags
+}
+
+func resourceComponentConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), componentConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// There is no API to delete a component configuration. Stop monitoring the component instead.
+	log.Printf("[DEBUG] Deleting ApplicationInsights Component Configuration: %s", d.Id())
+	input := applicationinsights.UpdateComponentConfigurationInput{
+		ComponentName:     aws.String(parts[1]),
+		Monitor:           aws.Bool(false),
+		ResourceGroupName: aws.String(parts[0]),
+	}
+	_, err = conn.UpdateComponentConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ApplicationInsights Component Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findComponentConfigurationByTwoPartKey(ctx context.Context, conn *applicationinsights.Client, resourceGroupName, componentName string) (*applicationinsights.DescribeComponentConfigurationOutput, error) {
+	input := applicationinsights.DescribeComponentConfigurationInput{
+		ComponentName:     aws.String(componentName),
+		ResourceGroupName: aws.String(resourceGroupName),
+	}
+
+	output, err := conn.DescribeComponentConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsComponentConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_component_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfigurationConfig_autoConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_config_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "component_configuration"),
+					resource.TestCheckResourceAttrPair(resourceName, "component_name", "aws_instance.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "monitor", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tier", "DEFAULT"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_config_enabled"},
+			},
+		},
+	})
+}
+
+func testAccCheckComponentConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_component_configuration" {
+				continue
+			}
+
+			output, err := tfapplicationinsights.FindComponentConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"])
+
+			// The component configuration is removed along with the application.
+			if err != nil {
+				continue
+			}
+
+			if aws.ToBool(output.Monitor) {
+				return fmt.Errorf("ApplicationInsights Component Configuration %s is still monitored", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		_, err := tfapplicationinsights.FindComponentConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["component_name"])
+
+		return err
+	}
+}
+
+func testAccComponentConfigurationConfig_autoConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_basic(rName),
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name  = %[1]q
+    Stage = "Test"
+  }
+}
+
+resource "aws_applicationinsights_component_configuration" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+  component_name      = aws_instance.test.arn
+  tier                = "DEFAULT"
+  auto_config_enabled = true
+}
+`, rName))
+}
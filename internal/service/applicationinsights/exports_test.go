@@ -5,7 +5,13 @@ package applicationinsights
 
 // Exports for use in tests only.
 var (
-	ResourceApplication = resourceApplication
+	ResourceApplication            = resourceApplication
+	ResourceComponentConfiguration = resourceComponentConfiguration
+	ResourceLogPattern             = resourceLogPattern
+	ResourceWorkload               = resourceWorkload
 
-	FindApplicationByName = findApplicationByName
+	FindApplicationByName                  = findApplicationByName
+	FindComponentConfigurationByTwoPartKey = findComponentConfigurationByTwoPartKey
+	FindLogPatternByThreePartKey           = findLogPatternByThreePartKey
+	FindWorkloadByThreePartKey             = findWorkloadByThreePartKey
 )
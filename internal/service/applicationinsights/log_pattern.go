@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationinsights/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_applicationinsights_log_pattern", name="Log Pattern")
+func resourceLogPattern() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLogPatternCreate,
+		ReadWithoutTimeout:   resourceLogPatternRead,
+		UpdateWithoutTimeout: resourceLogPatternUpdate,
+		DeleteWithoutTimeout: resourceLogPatternDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"pattern_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"pattern_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 30),
+			},
+			"rank": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	logPatternResourceIDPartCount = 3
+)
+
+func resourceLogPatternCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	resourceGroupName, patternSetName, patternName := d.Get("resource_group_name").(string), d.Get("pattern_set_name").(string), d.Get("pattern_name").(string)
+	id, err := flex.FlattenResourceId([]string{resourceGroupName, patternSetName, patternName}, logPatternResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := applicationinsights.CreateLogPatternInput{
+		Pattern:           aws.String(d.Get("pattern").(string)),
+		PatternName:       aws.String(patternName),
+		PatternSetName:    aws.String(patternSetName),
+		Rank:              int32(d.Get("rank").(int)),
+		ResourceGroupName: aws.String(resourceGroupName),
+	}
+
+	_, err = conn.CreateLogPattern(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ApplicationInsights Log Pattern (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceLogPatternRead(ctx, d, meta)...)
+}
+
+func resourceLogPatternRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), logPatternResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resourceGroupName := parts[0]
+	pattern, err := findLogPatternByThreePartKey(ctx, conn, resourceGroupName, parts[1], parts[2])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ApplicationInsights Log Pattern (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ApplicationInsights Log Pattern (%s): %s", d.Id(), err)
+	}
+
+	d.Set("pattern", pattern.Pattern)
+	d.Set("pattern_name", pattern.PatternName)
+	d.Set("pattern_set_name", pattern.PatternSetName)
+	d.Set("rank", pattern.Rank)
+	d.Set("resource_group_name", resourceGroupName)
+
+	return diags
+}
+
+func resourceLogPatternUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), logPatternResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := applicationinsights.UpdateLogPatternInput{
+		Pattern:           aws.String(d.Get("pattern").(string)),
+		PatternName:       aws.String(parts[2]),
+		PatternSetName:    aws.String(parts[1]),
+		Rank:              int32(d.Get("rank").(int)),
+		ResourceGroupName: aws.String(parts[0]),
+	}
+
+	_, err = conn.UpdateLogPattern(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ApplicationInsights Log Pattern (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLogPatternRead(ctx, d, meta)...)
+}
+
+func resourceLogPatternDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), logPatternResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting ApplicationInsights Log Pattern: %s", d.Id())
+	input := applicationinsights.DeleteLogPatternInput{
+		PatternName:       aws.String(parts[2]),
+		PatternSetName:    aws.String(parts[1]),
+		ResourceGroupName: aws.String(parts[0]),
+	}
+	_, err = conn.DeleteLogPattern(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ApplicationInsights Log Pattern (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findLogPatternByThreePartKey(ctx context.Context, conn *applicationinsights.Client, resourceGroupName, patternSetName, patternName string) (*awstypes.LogPattern, error) {
+	input := applicationinsights.DescribeLogPatternInput{
+		PatternName:       aws.String(patternName),
+		PatternSetName:    aws.String(patternSetName),
+		ResourceGroupName: aws.String(resourceGroupName),
+	}
+
+	output, err := conn.DescribeLogPattern(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LogPattern == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LogPattern, nil
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationinsights/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_applicationinsights_workload", name="Workload")
+func resourceWorkload() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkloadCreate,
+		ReadWithoutTimeout:   resourceWorkloadRead,
+		UpdateWithoutTimeout: resourceWorkloadUpdate,
+		DeleteWithoutTimeout: resourceWorkloadDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"component_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"configuration": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Computed:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tier": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Tier](),
+			},
+			"workload_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workload_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	workloadResourceIDPartCount = 3
+)
+
+func resourceWorkloadCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	resourceGroupName, componentName := d.Get("resource_group_name").(string), d.Get("component_name").(string)
+	input := applicationinsights.AddWorkloadInput{
+		ComponentName:         aws.String(componentName),
+		ResourceGroupName:     aws.String(resourceGroupName),
+		WorkloadConfiguration: expandWorkloadConfiguration(d),
+	}
+
+	output, err := conn.AddWorkload(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ApplicationInsights Workload (%s): %s", d.Get("workload_name").(string), err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{resourceGroupName, componentName, aws.ToString(output.WorkloadId)}, workloadResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceWorkloadRead(ctx, d, meta)...)
+}
+
+func resourceWorkloadRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workloadResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resourceGroupName, componentName, workloadID := parts[0], parts[1], parts[2]
+	output, err := findWorkloadByThreePartKey(ctx, conn, resourceGroupName, componentName, workloadID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ApplicationInsights Workload (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ApplicationInsights Workload (%s): %s", d.Id(), err)
+	}
+
+	d.Set("component_name", componentName)
+	d.Set("configuration", output.Configuration)
+	d.Set("resource_group_name", resourceGroupName)
+	d.Set("tier", output.Tier)
+	d.Set("workload_id", workloadID)
+	d.Set("workload_name", output.WorkloadName)
+
+	return diags
+}
+
+func resourceWorkloadUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workloadResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := applicationinsights.UpdateWorkloadInput{
+		ComponentName:         aws.String(parts[1]),
+		ResourceGroupName:     aws.String(parts[0]),
+		WorkloadConfiguration: expandWorkloadConfiguration(d),
+		WorkloadId:            aws.String(parts[2]),
+	}
+
+	_, err = conn.UpdateWorkload(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ApplicationInsights Workload (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceWorkloadRead(ctx, d, meta)...)
+}
+
+func resourceWorkloadDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workloadResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting ApplicationInsights Workload: %s", d.Id())
+	input := applicationinsights.RemoveWorkloadInput{
+		ComponentName:     aws.String(parts[1]),
+		ResourceGroupName: aws.String(parts[0]),
+		WorkloadId:        aws.String(parts[2]),
+	}
+	_, err = conn.RemoveWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ApplicationInsights Workload (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findWorkloadByThreePartKey(ctx context.Context, conn *applicationinsights.Client, resourceGroupName, componentName, workloadID string) (*awstypes.WorkloadConfiguration, error) {
+	input := applicationinsights.DescribeWorkloadInput{
+		ComponentName:     aws.String(componentName),
+		ResourceGroupName: aws.String(resourceGroupName),
+		WorkloadId:        aws.String(workloadID),
+	}
+
+	output, err := conn.DescribeWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WorkloadConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WorkloadConfiguration, nil
+}
+
+func expandWorkloadConfiguration(d *schema.ResourceData) *awstypes.WorkloadConfiguration {
+	apiObject := &awstypes.WorkloadConfiguration{
+		Tier:         awstypes.Tier(d.Get("tier").(string)),
+		WorkloadName: aws.String(d.Get("workload_name").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		apiObject.Configuration = aws.String(v.(string))
+	}
+
+	return apiObject
+}
@@ -99,6 +99,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Organization Admin Account Registration",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newSettingsResource,
+			TypeName: "aws_auditmanager_settings",
+			Name:     "Settings",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
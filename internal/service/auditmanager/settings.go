@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_auditmanager_settings", name="Settings")
+func newSettingsResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &settingsResource{}, nil
+}
+
+type settingsResource struct {
+	framework.ResourceWithModel[settingsResourceModel]
+	framework.WithImportByID
+}
+
+func (r *settingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"evidence_finder_backfill_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvidenceFinderBackfillStatus](),
+				Computed:   true,
+			},
+			"evidence_finder_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"evidence_finder_enablement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvidenceFinderEnablementStatus](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"sns_topic": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_assessment_reports_destination": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assessmentReportsDestinationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: schema.StringAttribute{
+							Required: true,
+						},
+						"destination_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AssessmentReportDestinationType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"default_export_destination": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[defaultExportDestinationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: schema.StringAttribute{
+							Required: true,
+						},
+						"destination_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ExportDestinationType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"default_process_owners": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[roleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"role_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RoleType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *settingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data settingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	// Settings are applied per region, so use this as the ID.
+	id := r.Meta().Region(ctx)
+	var input auditmanager.UpdateSettingsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateSettings(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Audit Manager Settings (%s)", id), err.Error())
+
+		return
+	}
+
+	output, err := findSettings(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Audit Manager Settings (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *settingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data settingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	output, err := findSettings(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Audit Manager Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *settingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old settingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var input auditmanager.UpdateSettingsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send evidence finder enablement when it changes, as disabling is irreversible.
+	if new.EvidenceFinderEnabled.Equal(old.EvidenceFinderEnabled) {
+		input.EvidenceFinderEnabled = nil
+	}
+
+	_, err := conn.UpdateSettings(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Audit Manager Settings (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := findSettings(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Audit Manager Settings (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *settingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Settings cannot be deleted, and evidence finder cannot be re-enabled once disabled.
+	// Removing this resource leaves the current settings in place.
+}
+
+func findSettings(ctx context.Context, conn *auditmanager.Client) (*awstypes.Settings, error) {
+	input := auditmanager.GetSettingsInput{
+		Attribute: awstypes.SettingAttributeAll,
+	}
+	output, err := conn.GetSettings(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Settings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Settings, nil
+}
+
+type settingsResourceModel struct {
+	framework.WithRegionModel
+	DefaultAssessmentReportsDestination fwtypes.ListNestedObjectValueOf[assessmentReportsDestinationModel] `tfsdk:"default_assessment_reports_destination"`
+	DefaultExportDestination            fwtypes.ListNestedObjectValueOf[defaultExportDestinationModel]     `tfsdk:"default_export_destination"`
+	DefaultProcessOwners                fwtypes.ListNestedObjectValueOf[roleModel]                         `tfsdk:"default_process_owners"`
+	EvidenceFinderBackfillStatus        fwtypes.StringEnum[awstypes.EvidenceFinderBackfillStatus]          `tfsdk:"evidence_finder_backfill_status" autoflex:"-"`
+	EvidenceFinderEnabled               types.Bool                                                         `tfsdk:"evidence_finder_enabled"`
+	EvidenceFinderEnablementStatus      fwtypes.StringEnum[awstypes.EvidenceFinderEnablementStatus]        `tfsdk:"evidence_finder_enablement_status" autoflex:"-"`
+	ID                                  types.String                                                       `tfsdk:"id"`
+	SNSTopic                            types.String                                                       `tfsdk:"sns_topic"`
+}
+
+func (m *settingsResourceModel) flatten(ctx context.Context, settings *awstypes.Settings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, settings, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := settings.EvidenceFinderEnablement; v != nil {
+		m.EvidenceFinderBackfillStatus = fwtypes.StringEnumValue(v.BackfillStatus)
+		m.EvidenceFinderEnabled = types.BoolValue(v.EnablementStatus == awstypes.EvidenceFinderEnablementStatusEnabled || v.EnablementStatus == awstypes.EvidenceFinderEnablementStatusEnableInProgress)
+		m.EvidenceFinderEnablementStatus = fwtypes.StringEnumValue(v.EnablementStatus)
+	} else {
+		m.EvidenceFinderBackfillStatus = fwtypes.StringEnumNull[awstypes.EvidenceFinderBackfillStatus]()
+		m.EvidenceFinderEnabled = types.BoolValue(false)
+		m.EvidenceFinderEnablementStatus = fwtypes.StringEnumNull[awstypes.EvidenceFinderEnablementStatus]()
+	}
+
+	return diags
+}
+
+type defaultExportDestinationModel struct {
+	Destination     types.String                                       `tfsdk:"destination"`
+	DestinationType fwtypes.StringEnum[awstypes.ExportDestinationType] `tfsdk:"destination_type"`
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:  testAccSettings_basic,
+		"process owners": testAccSettings_defaultProcessOwners,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+// Evidence finder is not toggled by these tests. Enabling it provisions a
+// CloudTrail Lake event data store, and disabling it is irreversible.
+
+func testAccSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_auditmanager_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_assessment_reports_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_assessment_reports_destination.0.destination_type", "S3"),
+					resource.TestCheckResourceAttrSet(resourceName, "evidence_finder_enabled"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic", "aws_sns_topic.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSettings_defaultProcessOwners(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_auditmanager_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsConfig_defaultProcessOwners(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_process_owners.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_process_owners.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "default_process_owners.0.role_type", "PROCESS_OWNER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSettingsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_account_registration" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSettingsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSettingsConfig_base(rName), `
+resource "aws_auditmanager_settings" "test" {
+  sns_topic = aws_sns_topic.test.arn
+
+  default_assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  depends_on = [aws_auditmanager_account_registration.test]
+}
+`)
+}
+
+func testAccSettingsConfig_defaultProcessOwners(rName string) string {
+	return acctest.ConfigCompose(testAccSettingsConfig_base(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "auditmanager.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_auditmanager_settings" "test" {
+  default_process_owners {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  depends_on = [aws_auditmanager_account_registration.test]
+}
+`, rName))
+}
@@ -24,6 +24,18 @@ func dataSourceGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"availability_zone_distribution": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity_distribution_strategy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrAvailabilityZones: {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -31,6 +43,40 @@ func dataSourceGroup() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"capacity_reservation_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity_reservation_preference": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"capacity_reservation_target": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"capacity_reservation_ids": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"capacity_reservation_resource_group_arns": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"default_cooldown": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -578,7 +624,21 @@ func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	d.SetId(aws.ToString(group.AutoScalingGroupName))
 	d.Set(names.AttrARN, group.AutoScalingGroupARN)
+	if group.AvailabilityZoneDistribution != nil {
+		if err := d.Set("availability_zone_distribution", []any{flattenAvailabilityZoneDistribution(group.AvailabilityZoneDistribution)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting availability_zone_distribution: %s", err)
+		}
+	} else {
+		d.Set("availability_zone_distribution", nil)
+	}
 	d.Set(names.AttrAvailabilityZones, group.AvailabilityZones)
+	if group.CapacityReservationSpecification != nil {
+		if err := d.Set("capacity_reservation_specification", []any{flattenCapacityReservationSpecification(group.CapacityReservationSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting capacity_reservation_specification: %s", err)
+		}
+	} else {
+		d.Set("capacity_reservation_specification", nil)
+	}
 	d.Set("default_cooldown", group.DefaultCooldown)
 	d.Set("desired_capacity", group.DesiredCapacity)
 	d.Set("desired_capacity_type", group.DesiredCapacityType)
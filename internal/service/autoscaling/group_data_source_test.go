@@ -28,7 +28,10 @@ func TestAccAutoScalingGroupDataSource_basic(t *testing.T) {
 				Config: testAccGroupDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "availability_zone_distribution.#", resourceName, "availability_zone_distribution.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "availability_zone_distribution.0.capacity_distribution_strategy", resourceName, "availability_zone_distribution.0.capacity_distribution_strategy"),
 					resource.TestCheckResourceAttrPair(datasourceName, "availability_zones.#", resourceName, "availability_zones.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "capacity_reservation_specification.#", resourceName, "capacity_reservation_specification.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, "default_cooldown", resourceName, "default_cooldown"),
 					resource.TestCheckResourceAttrPair(datasourceName, "desired_capacity", resourceName, "desired_capacity"),
 					resource.TestCheckResourceAttrPair(datasourceName, "desired_capacity_type", resourceName, "desired_capacity_type"),
@@ -206,6 +209,9 @@ resource "aws_autoscaling_group" "test" {
   desired_capacity          = 0
   enabled_metrics           = ["GroupDesiredCapacity"]
   force_delete              = true
+  availability_zone_distribution {
+    capacity_distribution_strategy = "balanced-only"
+  }
   instance_maintenance_policy {
     min_healthy_percentage = 90
     max_healthy_percentage = 120
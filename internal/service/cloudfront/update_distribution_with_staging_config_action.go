@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_cloudfront_update_distribution_with_staging_config, name="Update Distribution With Staging Config")
+func newUpdateDistributionWithStagingConfigAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &updateDistributionWithStagingConfigAction{}, nil
+}
+
+var (
+	_ action.Action = (*updateDistributionWithStagingConfigAction)(nil)
+)
+
+type updateDistributionWithStagingConfigAction struct {
+	framework.ActionWithModel[updateDistributionWithStagingConfigModel]
+}
+
+type updateDistributionWithStagingConfigModel struct {
+	DistributionID        types.String `tfsdk:"distribution_id"`
+	StagingDistributionID types.String `tfsdk:"staging_distribution_id"`
+	Timeout               types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *updateDistributionWithStagingConfigAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	distributionIDValidator := stringvalidator.RegexMatches(
+		regexache.MustCompile(`^[A-Z0-9]+$`),
+		"must be a valid CloudFront distribution ID (e.g., E1GHKQ2EXAMPLE)",
+	)
+
+	resp.Schema = schema.Schema{
+		Description: "Promotes a CloudFront staging distribution by copying its configuration to the primary distribution. This action waits for the primary distribution to be deployed.",
+		Attributes: map[string]schema.Attribute{
+			"distribution_id": schema.StringAttribute{
+				Description: "The ID of the primary CloudFront distribution to update",
+				Required:    true,
+				Validators: []validator.String{
+					distributionIDValidator,
+				},
+			},
+			"staging_distribution_id": schema.StringAttribute{
+				Description: "The ID of the staging CloudFront distribution whose configuration is copied to the primary distribution",
+				Required:    true,
+				Validators: []validator.String{
+					distributionIDValidator,
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the primary distribution to be deployed (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(5400),
+				},
+			},
+		},
+	}
+}
+
+func (a *updateDistributionWithStagingConfigAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateDistributionWithStagingConfigModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	distributionID := config.DistributionID.ValueString()
+	stagingDistributionID := config.StagingDistributionID.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting CloudFront update distribution with staging config action", map[string]any{
+		"distribution_id":         distributionID,
+		"staging_distribution_id": stagingDistributionID,
+		names.AttrTimeout:         timeout.String(),
+	})
+
+	// Send initial progress update
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Promoting CloudFront staging distribution %s to primary distribution %s...", stagingDistributionID, distributionID),
+	})
+
+	// Both distributions must exist and their current ETags are required
+	primary, err := findDistributionByID(ctx, conn, distributionID)
+	if err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Distribution Not Found",
+				fmt.Sprintf("CloudFront distribution %s was not found", distributionID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Distribution",
+			fmt.Sprintf("Could not describe CloudFront distribution %s: %s", distributionID, err),
+		)
+		return
+	}
+
+	staging, err := findDistributionByID(ctx, conn, stagingDistributionID)
+	if err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Staging Distribution Not Found",
+				fmt.Sprintf("CloudFront staging distribution %s was not found", stagingDistributionID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Staging Distribution",
+			fmt.Sprintf("Could not describe CloudFront staging distribution %s: %s", stagingDistributionID, err),
+		)
+		return
+	}
+
+	if !aws.ToBool(staging.Distribution.DistributionConfig.Staging) {
+		resp.Diagnostics.AddError(
+			"Invalid Staging Distribution",
+			fmt.Sprintf("CloudFront distribution %s is not a staging distribution", stagingDistributionID),
+		)
+		return
+	}
+
+	if aws.ToString(primary.Distribution.DistributionConfig.ContinuousDeploymentPolicyId) == "" {
+		resp.Diagnostics.AddError(
+			"Missing Continuous Deployment Policy",
+			fmt.Sprintf("CloudFront distribution %s has no continuous deployment policy attached", distributionID),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Copying configuration of staging distribution %s to primary distribution %s...", stagingDistributionID, distributionID),
+	})
+
+	input := cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id: aws.String(distributionID),
+		// The current ETags of both distributions, in the format "<primary ETag>, <staging ETag>".
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", aws.ToString(primary.ETag), aws.ToString(staging.ETag))),
+		StagingDistributionId: aws.String(stagingDistributionID),
+	}
+
+	_, err = conn.UpdateDistributionWithStagingConfig(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Distribution With Staging Config",
+			fmt.Sprintf("Could not update CloudFront distribution %s with staging distribution %s configuration: %s", distributionID, stagingDistributionID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Primary distribution %s updated, waiting for deployment...", distributionID),
+	})
+
+	// Wait for the primary distribution to be deployed with periodic progress updates using actionwait
+	// Use fixed interval since CloudFront distribution deployments take several minutes and
+	// don't benefit from exponential backoff
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		output, gerr := findDistributionByID(ctx, conn, distributionID)
+		if gerr != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("getting distribution status: %w", gerr)
+		}
+		status := aws.ToString(output.Distribution.Status)
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(status)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates:    []actionwait.Status{distributionStatusDeployed},
+		TransitionalStates: []actionwait.Status{
+			distributionStatusInProgress,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Distribution %s is currently '%s', continuing to wait for deployment...", distributionID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Distribution to Deploy",
+				fmt.Sprintf("CloudFront distribution %s was not deployed within %s: %s", distributionID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Invalid Distribution State",
+				fmt.Sprintf("CloudFront distribution %s entered unexpected state: %s", distributionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Failed While Waiting for Distribution",
+				fmt.Sprintf("Error waiting for CloudFront distribution %s: %s", distributionID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CloudFront staging distribution %s successfully promoted to primary distribution %s", stagingDistributionID, distributionID),
+	})
+
+	tflog.Info(ctx, "CloudFront update distribution with staging config action completed successfully", map[string]any{
+		"distribution_id":         distributionID,
+		"staging_distribution_id": stagingDistributionID,
+	})
+}
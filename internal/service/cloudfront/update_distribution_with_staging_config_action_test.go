@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontUpdateDistributionWithStagingConfigAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	stagingDomain := "www.example.org"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateDistributionWithStagingConfigActionConfig_init(stagingDomain),
+			},
+			{
+				Config: testAccUpdateDistributionWithStagingConfigActionConfig_attached(stagingDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionOriginDomainName(ctx, &distribution, defaultDomain),
+				),
+			},
+			{
+				Config: testAccUpdateDistributionWithStagingConfigActionConfig_basic(stagingDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionOriginDomainName(ctx, &distribution, stagingDomain),
+				),
+				// The primary distribution's configuration has been replaced out of band.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionOriginDomainName(ctx context.Context, distribution *awstypes.Distribution, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		output, err := tfcloudfront.FindDistributionByID(ctx, conn, aws.ToString(distribution.Id))
+
+		if err != nil {
+			return err
+		}
+
+		if origins := output.Distribution.DistributionConfig.Origins; origins == nil || len(origins.Items) != 1 {
+			return fmt.Errorf("CloudFront Distribution (%s) does not have exactly one origin", aws.ToString(distribution.Id))
+		}
+
+		if got := aws.ToString(output.Distribution.DistributionConfig.Origins.Items[0].DomainName); got != want {
+			return fmt.Errorf("CloudFront Distribution (%s) origin domain name = %s, want %s", aws.ToString(distribution.Id), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateDistributionWithStagingConfigActionConfig_policy() string {
+	return `
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+`
+}
+
+func testAccUpdateDistributionWithStagingConfigActionConfig_init(stagingDomain string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfigBase_staging(stagingDomain),
+		testAccContinuousDeploymentPolicyConfigBase_productionInit(defaultDomain),
+		testAccUpdateDistributionWithStagingConfigActionConfig_policy(),
+	)
+}
+
+func testAccUpdateDistributionWithStagingConfigActionConfig_attached(stagingDomain string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfigBase_staging(stagingDomain),
+		testAccContinuousDeploymentPolicyConfigBase_production(defaultDomain),
+		testAccUpdateDistributionWithStagingConfigActionConfig_policy(),
+	)
+}
+
+func testAccUpdateDistributionWithStagingConfigActionConfig_basic(stagingDomain string) string {
+	return acctest.ConfigCompose(
+		testAccUpdateDistributionWithStagingConfigActionConfig_attached(stagingDomain),
+		`
+action "aws_cloudfront_update_distribution_with_staging_config" "test" {
+  config {
+    distribution_id         = aws_cloudfront_distribution.test.id
+    staging_distribution_id = aws_cloudfront_distribution.staging.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_cloudfront_update_distribution_with_staging_config.test]
+    }
+  }
+}
+`)
+}
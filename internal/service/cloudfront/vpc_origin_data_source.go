@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudfront_vpc_origin", name="VPC Origin")
+func newVPCOriginDataSource(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &vpcOriginDataSource{}
+
+	return d, nil
+}
+
+type vpcOriginDataSource struct {
+	framework.DataSourceWithModel[vpcOriginDataSourceModel]
+}
+
+const (
+	DSNameVPCOrigin = "VPC Origin Data Source"
+)
+
+func (d *vpcOriginDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			"vpc_origin_endpoint_config": framework.DataSourceComputedListOfObjectAttribute[vpcOriginEndpointConfigDataSourceModel](ctx),
+		},
+	}
+}
+
+func (d *vpcOriginDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	conn := d.Meta().CloudFrontClient(ctx)
+	var data vpcOriginDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findVPCOriginByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CloudFront, create.ErrActionReading, DSNameVPCOrigin, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.VpcOrigin, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type vpcOriginDataSourceModel struct {
+	ARN                     types.String                                                            `tfsdk:"arn"`
+	ETag                    types.String                                                            `tfsdk:"etag"`
+	ID                      types.String                                                            `tfsdk:"id"`
+	Status                  types.String                                                            `tfsdk:"status"`
+	VPCOriginEndpointConfig fwtypes.ListNestedObjectValueOf[vpcOriginEndpointConfigDataSourceModel] `tfsdk:"vpc_origin_endpoint_config"`
+}
+
+type vpcOriginEndpointConfigDataSourceModel struct {
+	ARN                  types.String                                                       `tfsdk:"arn"`
+	HTTPPort             types.Int64                                                        `tfsdk:"http_port"`
+	HTTPSPort            types.Int64                                                        `tfsdk:"https_port"`
+	Name                 types.String                                                       `tfsdk:"name"`
+	OriginProtocolPolicy fwtypes.StringEnum[awstypes.OriginProtocolPolicy]                  `tfsdk:"origin_protocol_policy"`
+	OriginSSLProtocols   fwtypes.ListNestedObjectValueOf[originSSLProtocolsDataSourceModel] `tfsdk:"origin_ssl_protocols"`
+}
+
+type originSSLProtocolsDataSourceModel struct {
+	Items    fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.SslProtocol]] `tfsdk:"items"`
+	Quantity types.Int64                                                  `tfsdk:"quantity"`
+}
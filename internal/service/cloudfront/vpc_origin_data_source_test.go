@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontVPCOriginDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudfront_vpc_origin.test"
+	resourceName := "aws_cloudfront_vpc_origin.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "Deployed"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_origin_endpoint_config.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_origin_endpoint_config.0.arn", resourceName, "vpc_origin_endpoint_config.0.arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_origin_endpoint_config.0.http_port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_origin_endpoint_config.0.https_port", "8443"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_origin_endpoint_config.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_origin_endpoint_config.0.origin_protocol_policy", "http-only"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_origin_endpoint_config.0.origin_ssl_protocols.0.items.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_origin_endpoint_config.0.origin_ssl_protocols.0.quantity", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCOriginDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_basic(rName), `
+data "aws_cloudfront_vpc_origin" "test" {
+  id = aws_cloudfront_vpc_origin.test.id
+}
+`)
+}
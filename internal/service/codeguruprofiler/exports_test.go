@@ -5,7 +5,9 @@ package codeguruprofiler
 
 // Exports for use in tests only.
 var (
-	ResourceProfilingGroup = newProfilingGroupResource
+	ResourceNotificationChannel = newNotificationChannelResource
+	ResourceProfilingGroup      = newProfilingGroupResource
 
-	FindProfilingGroupByName = findProfilingGroupByName
+	FindNotificationChannelByTwoPartKey = findNotificationChannelByTwoPartKey
+	FindProfilingGroupByName            = findProfilingGroupByName
 )
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_codeguruprofiler_notification_channel", name="Notification Channel")
+func newNotificationChannelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &notificationChannelResource{}
+
+	return r, nil
+}
+
+type notificationChannelResource struct {
+	framework.ResourceWithModel[notificationChannelResourceModel]
+	framework.WithImportByID
+	framework.WithNoUpdate
+}
+
+func (r *notificationChannelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_publishers": schema.SetAttribute{
+				CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.EventPublisher]](ctx),
+				ElementType: fwtypes.StringEnumType[awstypes.EventPublisher](),
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"profiling_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrURI: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *notificationChannelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data notificationChannelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	name, uri := data.ProfilingGroupName.ValueString(), data.URI.ValueString()
+	var channel awstypes.Channel
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &channel)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := codeguruprofiler.AddNotificationChannelsInput{
+		Channels:           []awstypes.Channel{channel},
+		ProfilingGroupName: aws.String(name),
+	}
+
+	output, err := conn.AddNotificationChannels(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CodeGuru Profiler Notification Channel (%s/%s)", name, uri), err.Error())
+
+		return
+	}
+
+	// The response contains all of the profiling group's channels. Find ours by URI.
+	v, err := tfresource.AssertSingleValueResult(tfslices.Filter(output.NotificationConfiguration.Channels, func(v awstypes.Channel) bool {
+		return aws.ToString(v.Uri) == uri
+	}))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CodeGuru Profiler Notification Channel (%s/%s)", name, uri), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ChannelID = fwflex.StringToFramework(ctx, v.Id)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewCreatingResourceIDErrorDiagnostic(err))
+		return
+	}
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *notificationChannelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data notificationChannelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	output, err := findNotificationChannelByTwoPartKey(ctx, conn, data.ProfilingGroupName.ValueString(), data.ChannelID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeGuru Profiler Notification Channel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *notificationChannelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data notificationChannelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	input := codeguruprofiler.RemoveNotificationChannelInput{
+		ChannelId:          fwflex.StringFromFramework(ctx, data.ChannelID),
+		ProfilingGroupName: fwflex.StringFromFramework(ctx, data.ProfilingGroupName),
+	}
+	_, err := conn.RemoveNotificationChannel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CodeGuru Profiler Notification Channel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findNotificationChannelByTwoPartKey(ctx context.Context, conn *codeguruprofiler.Client, profilingGroupName, channelID string) (*awstypes.Channel, error) {
+	input := codeguruprofiler.GetNotificationConfigurationInput{
+		ProfilingGroupName: aws.String(profilingGroupName),
+	}
+	output, err := conn.GetNotificationConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NotificationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output.NotificationConfiguration.Channels, func(v awstypes.Channel) bool {
+		return aws.ToString(v.Id) == channelID
+	}))
+}
+
+type notificationChannelResourceModel struct {
+	framework.WithRegionModel
+	ChannelID          types.String                                                    `tfsdk:"channel_id"`
+	EventPublishers    fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.EventPublisher]] `tfsdk:"event_publishers"`
+	ID                 types.String                                                    `tfsdk:"id"`
+	ProfilingGroupName types.String                                                    `tfsdk:"profiling_group_name"`
+	URI                fwtypes.ARN                                                     `tfsdk:"uri"`
+}
+
+const (
+	notificationChannelResourceIDPartCount = 2
+)
+
+func (data *notificationChannelResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, notificationChannelResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ProfilingGroupName = types.StringValue(parts[0])
+	data.ChannelID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *notificationChannelResourceModel) setID() (string, error) {
+	parts := []string{
+		data.ProfilingGroupName.ValueString(),
+		data.ChannelID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, notificationChannelResourceIDPartCount, false)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeguruprofiler "github.com/hashicorp/terraform-provider-aws/internal/service/codeguruprofiler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeGuruProfilerNotificationChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Channel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_notification_channel.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "event_publishers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "event_publishers.*", "AnomalyDetection"),
+					resource.TestCheckResourceAttr(resourceName, "profiling_group_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrURI, topicResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerNotificationChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Channel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_notification_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationChannelExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeguruprofiler.ResourceNotificationChannel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNotificationChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeguruprofiler_notification_channel" {
+				continue
+			}
+
+			_, err := tfcodeguruprofiler.FindNotificationChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["profiling_group_name"], rs.Primary.Attributes["channel_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeGuru Profiler Notification Channel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNotificationChannelExists(ctx context.Context, n string, v *awstypes.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
+
+		output, err := tfcodeguruprofiler.FindNotificationChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["profiling_group_name"], rs.Primary.Attributes["channel_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNotificationChannelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name             = %[1]q
+  compute_platform = "Default"
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["sns:Publish"]
+    resources = [aws_sns_topic.test.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["codeguru-profiler.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_sns_topic_policy" "test" {
+  arn    = aws_sns_topic.test.arn
+  policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_codeguruprofiler_notification_channel" "test" {
+  profiling_group_name = aws_codeguruprofiler_profiling_group.test.name
+  uri                  = aws_sns_topic.test.arn
+  event_publishers     = ["AnomalyDetection"]
+
+  depends_on = [aws_sns_topic_policy.test]
+}
+`, rName)
+}
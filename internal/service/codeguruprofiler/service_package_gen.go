@@ -30,6 +30,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newNotificationChannelResource,
+			TypeName: "aws_codeguruprofiler_notification_channel",
+			Name:     "Notification Channel",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newProfilingGroupResource,
 			TypeName: "aws_codeguruprofiler_profiling_group",
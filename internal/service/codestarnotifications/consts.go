@@ -6,3 +6,17 @@ package codestarnotifications
 const (
 	ResNotificationRule = "Notification Rule"
 )
+
+const (
+	targetTypeAWSChatbotMicrosoftTeams = "AWSChatbotMicrosoftTeams"
+	targetTypeAWSChatbotSlack          = "AWSChatbotSlack"
+	targetTypeSNS                      = "SNS"
+)
+
+func targetType_Values() []string {
+	return []string{
+		targetTypeAWSChatbotMicrosoftTeams,
+		targetTypeAWSChatbotSlack,
+		targetTypeSNS,
+	}
+}
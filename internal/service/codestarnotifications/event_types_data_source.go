@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codestarnotifications
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go-v2/service/codestarnotifications/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_codestarnotifications_event_types", name="Event Types")
+func dataSourceEventTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_type_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_type_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrServiceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrResourceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrServiceName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceEventTypesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarNotificationsClient(ctx)
+
+	var input codestarnotifications.ListEventTypesInput
+	if v, ok := d.GetOk(names.AttrResourceType); ok {
+		input.Filters = append(input.Filters, types.ListEventTypesFilter{
+			Name:  types.ListEventTypesFilterNameResourceType,
+			Value: aws.String(v.(string)),
+		})
+	}
+	if v, ok := d.GetOk(names.AttrServiceName); ok {
+		input.Filters = append(input.Filters, types.ListEventTypesFilter{
+			Name:  types.ListEventTypesFilterNameServiceName,
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	eventTypes, err := findEventTypes(ctx, conn, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeStar Notification Event Types: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	d.Set("event_type_ids", tfslices.ApplyToAll(eventTypes, func(v types.EventTypeSummary) string {
+		return aws.ToString(v.EventTypeId)
+	}))
+	if err := d.Set("event_types", flattenEventTypeSummaries(eventTypes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting event_types: %s", err)
+	}
+
+	return diags
+}
+
+func findEventTypes(ctx context.Context, conn *codestarnotifications.Client, input *codestarnotifications.ListEventTypesInput) ([]types.EventTypeSummary, error) {
+	var output []types.EventTypeSummary
+
+	pages := codestarnotifications.NewListEventTypesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EventTypes...)
+	}
+
+	return output, nil
+}
+
+func flattenEventTypeSummaries(apiObjects []types.EventTypeSummary) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"event_type_id":        aws.ToString(apiObject.EventTypeId),
+			"event_type_name":      aws.ToString(apiObject.EventTypeName),
+			names.AttrResourceType: aws.ToString(apiObject.ResourceType),
+			names.AttrServiceName:  aws.ToString(apiObject.ServiceName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codestarnotifications_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeStarNotificationsEventTypesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_codestarnotifications_event_types.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarNotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "event_type_ids.*", "codecommit-repository-comments-on-commits"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "event_types.*", map[string]string{
+						"event_type_id":        "codecommit-repository-comments-on-commits",
+						names.AttrResourceType: "Repository",
+						names.AttrServiceName:  "CodeCommit",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccEventTypesDataSourceConfig_basic = `
+data "aws_codestarnotifications_event_types" "test" {
+  service_name = "CodeCommit"
+}
+`
@@ -5,6 +5,7 @@ package codestarnotifications
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -40,6 +41,8 @@ func resourceNotificationRule() *schema.Resource {
 		UpdateWithoutTimeout: resourceNotificationRuleUpdate,
 		DeleteWithoutTimeout: resourceNotificationRuleDelete,
 
+		CustomizeDiff: resourceNotificationRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -95,9 +98,10 @@ func resourceNotificationRule() *schema.Resource {
 							Computed: true,
 						},
 						names.AttrType: {
-							Type:     schema.TypeString,
-							Default:  "SNS",
-							Optional: true,
+							Type:         schema.TypeString,
+							Default:      targetTypeSNS,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(targetType_Values(), false),
 						},
 					},
 				},
@@ -223,6 +227,34 @@ func resourceNotificationRuleDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceNotificationRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown(names.AttrTarget) {
+		return nil
+	}
+
+	// A target address can only be subscribed to a notification rule once.
+	// Catch duplicates, e.g. the same Chatbot channel configured with two different target types, at plan time.
+	addresses := make(map[string]struct{})
+	for _, tfMapRaw := range d.Get(names.AttrTarget).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		address, _ := tfMap[names.AttrAddress].(string)
+		if address == "" {
+			continue
+		}
+
+		if _, ok := addresses[address]; ok {
+			return fmt.Errorf("duplicate target address (%s)", address)
+		}
+		addresses[address] = struct{}{}
+	}
+
+	return nil
+}
+
 func findNotificationRuleByARN(ctx context.Context, conn *codestarnotifications.Client, arn string) (*codestarnotifications.DescribeNotificationRuleOutput, error) {
 	input := &codestarnotifications.DescribeNotificationRuleInput{
 		Arn: aws.String(arn),
@@ -160,6 +160,24 @@ func TestAccCodeStarNotificationsNotificationRule_targets(t *testing.T) {
 	})
 }
 
+func TestAccCodeStarNotificationsNotificationRule_duplicateTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarNotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNotificationRuleConfig_duplicateTargets(rName),
+				ExpectError: regexache.MustCompile(`duplicate target address`),
+			},
+		},
+	})
+}
+
 func TestAccCodeStarNotificationsNotificationRule_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_codestarnotifications_notification_rule.test"
@@ -387,6 +405,26 @@ resource "aws_codestarnotifications_notification_rule" "test" {
 `, rName))
 }
 
+func testAccNotificationRuleConfig_duplicateTargets(rName string) string {
+	return acctest.ConfigCompose(testAccNotificationRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_codestarnotifications_notification_rule" "test" {
+  detail_type    = "BASIC"
+  event_type_ids = ["codecommit-repository-comments-on-commits"]
+  name           = %[1]q
+  resource       = aws_codecommit_repository.test.arn
+
+  target {
+    address = aws_sns_topic.test.arn
+  }
+
+  target {
+    address = aws_sns_topic.test.arn
+    type    = "AWSChatbotSlack"
+  }
+}
+`, rName))
+}
+
 func testAccNotificationRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccNotificationRuleConfig_base(rName), fmt.Sprintf(`
 resource "aws_codestarnotifications_notification_rule" "test" {
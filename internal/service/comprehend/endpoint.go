@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_comprehend_endpoint", name="Endpoint")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &endpointResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type endpointResource struct {
+	framework.ResourceWithModel[endpointResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *endpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"current_inference_units": schema.Int64Attribute{
+				Computed: true,
+			},
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"desired_inference_units": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"flywheel_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("model_arn")),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"model_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *endpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data endpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	name := data.EndpointName.ValueString()
+	var input comprehend.CreateEndpointInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := tfresource.RetryWhenIsAErrorMessageContains[*comprehend.CreateEndpointOutput, *awstypes.InvalidRequestException](ctx, iamPropagationTimeout, func(ctx context.Context) (*comprehend.CreateEndpointOutput, error) {
+		return conn.CreateEndpoint(ctx, &input)
+	}, "Failed to assume role")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Comprehend Endpoint (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.EndpointArn)
+	data.EndpointARN = fwflex.StringValueToFramework(ctx, arn)
+	data.ID = fwflex.StringValueToFramework(ctx, arn)
+
+	endpoint, err := waitEndpointInService(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Endpoint (%s) create", arn), err.Error())
+
+		return
+	}
+
+	data.CurrentInferenceUnits = fwflex.Int32ToFrameworkInt64(ctx, endpoint.CurrentInferenceUnits)
+	data.ModelARN = fwtypes.ARNValue(aws.ToString(endpoint.ModelArn))
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *endpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data endpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findEndpointByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Comprehend Endpoint (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// DescribeEndpoint doesn't return the endpoint name.
+	name, err := endpointParseARN(arn)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Comprehend Endpoint (%s)", arn), err.Error())
+
+		return
+	}
+	data.EndpointName = fwflex.StringValueToFramework(ctx, name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *endpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old endpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	arn := new.ID.ValueString()
+
+	if !new.DataAccessRoleARN.Equal(old.DataAccessRoleARN) ||
+		!new.DesiredInferenceUnits.Equal(old.DesiredInferenceUnits) ||
+		!new.FlywheelARN.Equal(old.FlywheelARN) ||
+		!new.ModelARN.Equal(old.ModelARN) {
+		input := comprehend.UpdateEndpointInput{
+			EndpointArn: aws.String(arn),
+		}
+
+		if !new.DataAccessRoleARN.Equal(old.DataAccessRoleARN) {
+			input.DesiredDataAccessRoleArn = fwflex.StringFromFramework(ctx, new.DataAccessRoleARN)
+		}
+
+		if !new.DesiredInferenceUnits.Equal(old.DesiredInferenceUnits) {
+			input.DesiredInferenceUnits = fwflex.Int32FromFrameworkInt64(ctx, new.DesiredInferenceUnits)
+		}
+
+		if !new.FlywheelARN.Equal(old.FlywheelARN) {
+			input.FlywheelArn = fwflex.StringFromFramework(ctx, new.FlywheelARN)
+		}
+
+		if !new.ModelARN.Equal(old.ModelARN) && !new.ModelARN.IsUnknown() {
+			input.DesiredModelArn = fwflex.StringFromFramework(ctx, new.ModelARN)
+		}
+
+		_, err := conn.UpdateEndpoint(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Comprehend Endpoint (%s)", arn), err.Error())
+
+			return
+		}
+
+		endpoint, err := waitEndpointInService(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Endpoint (%s) update", arn), err.Error())
+
+			return
+		}
+
+		new.CurrentInferenceUnits = fwflex.Int32ToFrameworkInt64(ctx, endpoint.CurrentInferenceUnits)
+		new.ModelARN = fwtypes.ARNValue(aws.ToString(endpoint.ModelArn))
+	} else {
+		new.CurrentInferenceUnits = old.CurrentInferenceUnits
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *endpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data endpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	arn := data.ID.ValueString()
+	input := comprehend.DeleteEndpointInput{
+		EndpointArn: aws.String(arn),
+	}
+	_, err := conn.DeleteEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Comprehend Endpoint (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitEndpointDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Endpoint (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func findEndpointByARN(ctx context.Context, conn *comprehend.Client, arn string) (*awstypes.EndpointProperties, error) {
+	input := comprehend.DescribeEndpointInput{
+		EndpointArn: aws.String(arn),
+	}
+	output, err := conn.DescribeEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EndpointProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EndpointProperties, nil
+}
+
+func statusEndpoint(ctx context.Context, conn *comprehend.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findEndpointByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEndpointInService(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*awstypes.EndpointProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EndpointStatusCreating, awstypes.EndpointStatusUpdating),
+		Target:  enum.Slice(awstypes.EndpointStatusInService),
+		Refresh: statusEndpoint(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.EndpointProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*awstypes.EndpointProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EndpointStatusDeleting),
+		Target:  []string{},
+		Refresh: statusEndpoint(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.EndpointProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func endpointParseARN(arnString string) (string, error) {
+	arn, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+	re := regexache.MustCompile(`^(?:document-classifier|entity-recognizer)-endpoint/([[:alnum:]-]+)`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unable to parse %q", arnString)
+	}
+	name := matches[1]
+
+	return name, nil
+}
+
+type endpointResourceModel struct {
+	framework.WithRegionModel
+	CurrentInferenceUnits types.Int64    `tfsdk:"current_inference_units"`
+	DataAccessRoleARN     fwtypes.ARN    `tfsdk:"data_access_role_arn"`
+	DesiredInferenceUnits types.Int64    `tfsdk:"desired_inference_units"`
+	EndpointARN           types.String   `tfsdk:"arn"`
+	EndpointName          types.String   `tfsdk:"name"`
+	FlywheelARN           fwtypes.ARN    `tfsdk:"flywheel_arn"`
+	ID                    types.String   `tfsdk:"id"`
+	ModelARN              fwtypes.ARN    `tfsdk:"model_arn"`
+	Tags                  tftags.Map     `tfsdk:"tags"`
+	TagsAll               tftags.Map     `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
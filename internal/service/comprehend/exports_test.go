@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+// Exports for use in tests only.
+var (
+	ResourceEndpoint = newEndpointResource
+	ResourceFlywheel = newFlywheelResource
+
+	FindEndpointByARN = findEndpointByARN
+	FindFlywheelByARN = findFlywheelByARN
+)
@@ -0,0 +1,550 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfobjectvalidator "github.com/hashicorp/terraform-provider-aws/internal/framework/validators/objectvalidator"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_comprehend_flywheel", name="Flywheel")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newFlywheelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flywheelResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type flywheelResource struct {
+	framework.ResourceWithModel[flywheelResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *flywheelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active_model_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"data_lake_s3_uri": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"latest_flywheel_iteration": schema.StringAttribute{
+				Computed: true,
+			},
+			"model_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ModelType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlywheelStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_security_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSecurityConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_lake_kms_key_id": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"model_kms_key_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"volume_kms_key_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrVPCConfig: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrSecurityGroupIDs: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									names.AttrSubnets: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"task_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[taskConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Validators: []validator.Object{
+						tfobjectvalidator.ExactlyOneOfChildren(
+							path.MatchRelative().AtName("document_classification_config"),
+							path.MatchRelative().AtName("entity_recognition_config"),
+						),
+					},
+					Attributes: map[string]schema.Attribute{
+						names.AttrLanguageCode: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.LanguageCode](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"document_classification_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[documentClassificationConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"labels": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									names.AttrMode: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.DocumentClassifierMode](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"entity_recognition_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityRecognitionConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"entity_types": schema.SetNestedBlock{
+										CustomType: fwtypes.NewSetNestedObjectTypeOf[entityTypesListItemModel](ctx),
+										Validators: []validator.Set{
+											setvalidator.IsRequired(),
+											setvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrType: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *flywheelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flywheelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	name := data.FlywheelName.ValueString()
+	var input comprehend.CreateFlywheelInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := tfresource.RetryWhenIsAErrorMessageContains[*comprehend.CreateFlywheelOutput, *awstypes.InvalidRequestException](ctx, iamPropagationTimeout, func(ctx context.Context) (*comprehend.CreateFlywheelOutput, error) {
+		return conn.CreateFlywheel(ctx, &input)
+	}, "Failed to assume role")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Comprehend Flywheel (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.FlywheelArn)
+	data.FlywheelARN = fwflex.StringValueToFramework(ctx, arn)
+	data.ID = fwflex.StringValueToFramework(ctx, arn)
+
+	flywheel, err := waitFlywheelActive(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Flywheel (%s) create", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, flywheel, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flywheelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flywheelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findFlywheelByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Comprehend Flywheel (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// DescribeFlywheel doesn't return the flywheel name.
+	name, err := flywheelParseARN(arn)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Comprehend Flywheel (%s)", arn), err.Error())
+
+		return
+	}
+	data.FlywheelName = fwflex.StringValueToFramework(ctx, name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flywheelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flywheelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	arn := new.ID.ValueString()
+
+	if !new.ActiveModelARN.Equal(old.ActiveModelARN) ||
+		!new.DataAccessRoleARN.Equal(old.DataAccessRoleARN) ||
+		!new.DataSecurityConfig.Equal(old.DataSecurityConfig) {
+		var input comprehend.UpdateFlywheelInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlywheelArn = aws.String(arn)
+
+		_, err := conn.UpdateFlywheel(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Comprehend Flywheel (%s)", arn), err.Error())
+
+			return
+		}
+
+		flywheel, err := waitFlywheelActive(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Flywheel (%s) update", arn), err.Error())
+
+			return
+		}
+
+		new.LatestFlywheelIteration = fwflex.StringToFramework(ctx, flywheel.LatestFlywheelIteration)
+		new.Status = fwtypes.StringEnumValue(flywheel.Status)
+	} else {
+		new.LatestFlywheelIteration = old.LatestFlywheelIteration
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flywheelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flywheelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComprehendClient(ctx)
+
+	arn := data.ID.ValueString()
+	input := comprehend.DeleteFlywheelInput{
+		FlywheelArn: aws.String(arn),
+	}
+	_, err := conn.DeleteFlywheel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Comprehend Flywheel (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlywheelDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Comprehend Flywheel (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func findFlywheelByARN(ctx context.Context, conn *comprehend.Client, arn string) (*awstypes.FlywheelProperties, error) {
+	input := comprehend.DescribeFlywheelInput{
+		FlywheelArn: aws.String(arn),
+	}
+	output, err := conn.DescribeFlywheel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FlywheelProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FlywheelProperties, nil
+}
+
+func statusFlywheel(ctx context.Context, conn *comprehend.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findFlywheelByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlywheelActive(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*awstypes.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlywheelStatusCreating, awstypes.FlywheelStatusUpdating),
+		Target:  enum.Slice(awstypes.FlywheelStatusActive),
+		Refresh: statusFlywheel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FlywheelProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlywheelDeleted(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*awstypes.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlywheelStatusDeleting),
+		Target:  []string{},
+		Refresh: statusFlywheel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FlywheelProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flywheelParseARN(arnString string) (string, error) {
+	arn, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+	re := regexache.MustCompile(`^flywheel/([[:alnum:]-]+)`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unable to parse %q", arnString)
+	}
+	name := matches[1]
+
+	return name, nil
+}
+
+type flywheelResourceModel struct {
+	framework.WithRegionModel
+	ActiveModelARN          fwtypes.ARN                                              `tfsdk:"active_model_arn"`
+	DataAccessRoleARN       fwtypes.ARN                                              `tfsdk:"data_access_role_arn"`
+	DataLakeS3URI           types.String                                             `tfsdk:"data_lake_s3_uri"`
+	DataSecurityConfig      fwtypes.ListNestedObjectValueOf[dataSecurityConfigModel] `tfsdk:"data_security_config"`
+	FlywheelARN             types.String                                             `tfsdk:"arn"`
+	FlywheelName            types.String                                             `tfsdk:"name"`
+	ID                      types.String                                             `tfsdk:"id"`
+	LatestFlywheelIteration types.String                                             `tfsdk:"latest_flywheel_iteration"`
+	ModelType               fwtypes.StringEnum[awstypes.ModelType]                   `tfsdk:"model_type"`
+	Status                  fwtypes.StringEnum[awstypes.FlywheelStatus]              `tfsdk:"status"`
+	Tags                    tftags.Map                                               `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                               `tfsdk:"tags_all"`
+	TaskConfig              fwtypes.ListNestedObjectValueOf[taskConfigModel]         `tfsdk:"task_config"`
+	Timeouts                timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type dataSecurityConfigModel struct {
+	DataLakeKmsKeyID types.String                                    `tfsdk:"data_lake_kms_key_id"`
+	ModelKmsKeyID    types.String                                    `tfsdk:"model_kms_key_id"`
+	VolumeKmsKeyID   types.String                                    `tfsdk:"volume_kms_key_id"`
+	VPCConfig        fwtypes.ListNestedObjectValueOf[vpcConfigModel] `tfsdk:"vpc_config"`
+}
+
+type vpcConfigModel struct {
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	Subnets          fwtypes.SetOfString `tfsdk:"subnets"`
+}
+
+type taskConfigModel struct {
+	DocumentClassificationConfig fwtypes.ListNestedObjectValueOf[documentClassificationConfigModel] `tfsdk:"document_classification_config"`
+	EntityRecognitionConfig      fwtypes.ListNestedObjectValueOf[entityRecognitionConfigModel]      `tfsdk:"entity_recognition_config"`
+	LanguageCode                 fwtypes.StringEnum[awstypes.LanguageCode]                          `tfsdk:"language_code"`
+}
+
+type documentClassificationConfigModel struct {
+	Labels fwtypes.SetOfString                                 `tfsdk:"labels"`
+	Mode   fwtypes.StringEnum[awstypes.DocumentClassifierMode] `tfsdk:"mode"`
+}
+
+type entityRecognitionConfigModel struct {
+	EntityTypes fwtypes.SetNestedObjectValueOf[entityTypesListItemModel] `tfsdk:"entity_types"`
+}
+
+type entityTypesListItemModel struct {
+	Type types.String `tfsdk:"type"`
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -41,6 +42,8 @@ func resourceDevicePool() *schema.Resource {
 		UpdateWithoutTimeout: resourceDevicePoolUpdate,
 		DeleteWithoutTimeout: resourceDevicePoolDelete,
 
+		CustomizeDiff: validateDevicePoolRules,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -81,8 +84,9 @@ func resourceDevicePool() *schema.Resource {
 							ValidateDiagFunc: enum.Validate[awstypes.RuleOperator](),
 						},
 						names.AttrValue: {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
 						},
 					},
 				},
@@ -251,6 +255,48 @@ func findDevicePoolByARN(ctx context.Context, conn *devicefarm.Client, arn strin
 	return output.DevicePool, nil
 }
 
+// devicePoolRuleOperators lists the operators supported by each device attribute.
+// See https://docs.aws.amazon.com/devicefarm/latest/APIReference/API_Rule.html.
+var devicePoolRuleOperators = map[awstypes.DeviceAttribute][]awstypes.RuleOperator{
+	awstypes.DeviceAttributeArn:                 {awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeAvailability:        {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeFleetType:           {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeFormFactor:          {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeInstanceArn:         {awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeInstanceLabels:      {awstypes.RuleOperatorContains},
+	awstypes.DeviceAttributeManufacturer:        {awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeModel:               {awstypes.RuleOperatorContains, awstypes.RuleOperatorEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributeOsVersion:           {awstypes.RuleOperatorEquals, awstypes.RuleOperatorGreaterThan, awstypes.RuleOperatorGreaterThanOrEquals, awstypes.RuleOperatorIn, awstypes.RuleOperatorLessThan, awstypes.RuleOperatorLessThanOrEquals, awstypes.RuleOperatorNotIn},
+	awstypes.DeviceAttributePlatform:            {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeRemoteAccessEnabled: {awstypes.RuleOperatorEquals},
+	awstypes.DeviceAttributeRemoteDebugEnabled:  {awstypes.RuleOperatorEquals},
+}
+
+func validateDevicePoolRules(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	for _, r := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		attribute, operator := awstypes.DeviceAttribute(tfMap["attribute"].(string)), awstypes.RuleOperator(tfMap["operator"].(string))
+		if attribute == "" || operator == "" {
+			continue
+		}
+
+		operators, ok := devicePoolRuleOperators[attribute]
+		if !ok {
+			continue
+		}
+
+		if !slices.Contains(operators, operator) {
+			return fmt.Errorf("rule attribute %q does not support operator %q, supported operators: %s", attribute, operator, strings.Join(enum.Slice(operators...), ", "))
+		}
+	}
+
+	return nil
+}
+
 func expandDevicePoolRules(s *schema.Set) []awstypes.Rule {
 	rules := make([]awstypes.Rule, 0)
 
@@ -68,6 +68,30 @@ func TestAccDeviceFarmDevicePool_basic(t *testing.T) {
 	})
 }
 
+func TestAccDeviceFarmDevicePool_ruleOperatorInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DeviceFarmEndpointID)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeviceFarmServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDevicePoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDevicePoolConfig_rule(rName, "PLATFORM", "IN", `["ANDROID"]`),
+				ExpectError: regexache.MustCompile(`rule attribute "PLATFORM" does not support operator "IN"`),
+			},
+		},
+	})
+}
+
 func TestAccDeviceFarmDevicePool_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pool awstypes.DevicePool
@@ -244,6 +268,20 @@ resource "aws_devicefarm_device_pool" "test" {
 `, rName))
 }
 
+func testAccDevicePoolConfig_rule(rName, attribute, operator, value string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_basic(rName), fmt.Sprintf(`
+resource "aws_devicefarm_device_pool" "test" {
+  name        = %[1]q
+  project_arn = aws_devicefarm_project.test.arn
+  rule {
+    attribute = %[2]q
+    operator  = %[3]q
+    value     = %[4]q
+  }
+}
+`, rName, attribute, operator, value))
+}
+
 func testAccDevicePoolConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccProjectConfig_basic(rName), fmt.Sprintf(`
 resource "aws_devicefarm_device_pool" "test" {
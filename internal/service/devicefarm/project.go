@@ -11,12 +11,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/devicefarm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/devicefarm/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -36,6 +38,11 @@ func resourceProject() *schema.Resource {
 		UpdateWithoutTimeout: resourceProjectUpdate,
 		DeleteWithoutTimeout: resourceProjectDelete,
 
+		CustomizeDiff: customdiff.ForceNewIfChange(names.AttrVPCConfig, func(_ context.Context, old, new, meta any) bool {
+			// VPC configuration can be changed but not removed.
+			return len(old.([]any)) > 0 && len(new.([]any)) == 0
+		}),
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -52,6 +59,31 @@ func resourceProject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCConfig: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Required: true,
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Required: true,
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -69,6 +101,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta any
 		input.DefaultJobTimeoutMinutes = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk(names.AttrVPCConfig); ok {
+		input.VpcConfig = expandProjectVPCConfig(v.([]any))
+	}
+
 	output, err := conn.CreateProject(ctx, input)
 
 	if err != nil {
@@ -104,6 +140,9 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set(names.AttrName, project.Name)
 	d.Set(names.AttrARN, arn)
 	d.Set("default_job_timeout_minutes", project.DefaultJobTimeoutMinutes)
+	if err := d.Set(names.AttrVPCConfig, flattenProjectVPCConfig(project.VpcConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+	}
 
 	return diags
 }
@@ -125,6 +164,10 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			input.DefaultJobTimeoutMinutes = aws.Int32(int32(d.Get("default_job_timeout_minutes").(int)))
 		}
 
+		if d.HasChange(names.AttrVPCConfig) {
+			input.VpcConfig = expandProjectVPCConfig(d.Get(names.AttrVPCConfig).([]any))
+		}
+
 		_, err := conn.UpdateProject(ctx, input)
 
 		if err != nil {
@@ -179,3 +222,33 @@ func findProjectByARN(ctx context.Context, conn *devicefarm.Client, arn string)
 
 	return output.Project, nil
 }
+
+func expandProjectVPCConfig(l []any) *awstypes.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]any)
+
+	config := &awstypes.VpcConfig{
+		VpcId:            aws.String(m[names.AttrVPCID].(string)),
+		SubnetIds:        flex.ExpandStringValueSet(m[names.AttrSubnetIDs].(*schema.Set)),
+		SecurityGroupIds: flex.ExpandStringValueSet(m[names.AttrSecurityGroupIDs].(*schema.Set)),
+	}
+
+	return config
+}
+
+func flattenProjectVPCConfig(conf *awstypes.VpcConfig) []any {
+	if conf == nil {
+		return []any{}
+	}
+
+	m := map[string]any{
+		names.AttrVPCID:            aws.ToString(conf.VpcId),
+		names.AttrSubnetIDs:        flex.FlattenStringValueSet(conf.SubnetIds),
+		names.AttrSecurityGroupIDs: flex.FlattenStringValueSet(conf.SecurityGroupIds),
+	}
+
+	return []any{m}
+}
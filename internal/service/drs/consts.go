@@ -5,6 +5,9 @@ package drs
 
 // Exports for use in tests only.
 const (
+	ResNameLaunchConfigurationTemplate   = "Launch Configuration Template"
+	ResPrefixLaunchConfigurationTemplate = "LaunchConfigurationTemplate"
+
 	ResNameReplicationConfigurationTemplate   = "Replication Configuration Template"
 	ResPrefixReplicationConfigurationTemplate = "ReplicationConfigurationTemplate"
 )
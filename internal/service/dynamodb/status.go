@@ -113,6 +113,28 @@ func statusReplicaDelete(ctx context.Context, conn *dynamodb.Client, tableName,
 	}
 }
 
+func statusGlobalTableWitness(ctx context.Context, conn *dynamodb.Client, tableName, region string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findTableByName(ctx, conn, tableName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output.GlobalTableWitnesses {
+			if aws.ToString(v.RegionName) == region {
+				return output, string(v.WitnessStatus), nil
+			}
+		}
+
+		return nil, "", nil
+	}
+}
+
 func statusGSI(ctx context.Context, conn *dynamodb.Client, tableName, indexName string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findGSIByTwoPartKey(ctx, conn, tableName, indexName)
@@ -144,6 +144,7 @@ func resourceTable() *schema.Resource {
 			}),
 			validateWarmThroughputCustomDiff,
 			validateTTLCustomDiff,
+			validateReplicaMultiRegionConsistencyCustomDiff,
 		),
 
 		SchemaVersion: 1,
@@ -226,6 +227,23 @@ func resourceTable() *schema.Resource {
 						},
 					},
 				},
+				"global_table_witness": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"region_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							names.AttrStatus: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
 				"hash_key": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -884,7 +902,7 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if v := d.Get("replica").(*schema.Set); v.Len() > 0 {
-		if err := createReplicas(ctx, conn, d.Id(), v.List(), true, d.Timeout(schema.TimeoutCreate)); err != nil {
+		if err := createReplicas(ctx, conn, d.Id(), v.List(), globalTableWitnessRegionName(d.Get("global_table_witness").([]any)), true, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), fmt.Errorf("replicas: %w", err))
 		}
 
@@ -996,6 +1014,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "replica", err)
 	}
 
+	if err := d.Set("global_table_witness", flattenGlobalTableWitnessDescriptions(table.GlobalTableWitnesses)); err != nil {
+		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "global_table_witness", err)
+	}
+
 	if table.TableClassSummary != nil {
 		d.Set("table_class", table.TableClassSummary.TableClass)
 	} else {
@@ -1352,7 +1374,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	replicaTagsChange := false
-	if d.HasChange("replica") {
+	if d.HasChanges("global_table_witness", "replica") {
 		replicaTagsChange = true
 
 		if err := updateReplica(ctx, conn, d); err != nil {
@@ -1393,7 +1415,7 @@ func resourceTableDelete(ctx context.Context, d *schema.ResourceData, meta any)
 
 	if replicas := d.Get("replica").(*schema.Set).List(); len(replicas) > 0 {
 		log.Printf("[DEBUG] Deleting DynamoDB Table replicas: %s", d.Id())
-		if err := deleteReplicas(ctx, conn, d.Id(), replicas, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if err := deleteReplicas(ctx, conn, d.Id(), replicas, globalTableWitnessRegionName(d.Get("global_table_witness").([]any)), d.Timeout(schema.TimeoutDelete)); err != nil {
 			// ValidationException: Replica specified in the Replica Update or Replica Delete action of the request was not found.
 			// ValidationException: Cannot add, delete, or update the local region through ReplicaUpdates. Use CreateTable, DeleteTable, or UpdateTable as required.
 			if !tfawserr.ErrMessageContains(err, errCodeValidationException, "request was not found") &&
@@ -1471,7 +1493,7 @@ func cycleStreamEnabled(ctx context.Context, conn *dynamodb.Client, id string, s
 	return nil
 }
 
-func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []any, create bool, timeout time.Duration) error {
+func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []any, witnessRegion string, create bool, timeout time.Duration) error {
 	// Duplicating this for MRSC Adoption. If using MRSC and CreateReplicationGroupMemberAction list isn't initiated for at least 2 replicas
 	// then the update table action will fail with
 	// "Unsupported table replica count for global tables with MultiRegionConsistency set to STRONG"
@@ -1495,8 +1517,11 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 		if numReplicasMRSC > 0 && numReplicasMRSC != numReplicas {
 			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency requires all replicas to use 'consistency_mode' set to 'STRONG' ")
 		}
-		if numReplicasMRSC == 1 {
-			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency requires exactly 2 replicas. ")
+		if witnessRegion != "" && numReplicasMRSC != 1 {
+			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency with a witness Region requires exactly 1 replica. ")
+		}
+		if witnessRegion == "" && numReplicasMRSC == 1 {
+			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency requires exactly 2 replicas, or 1 replica and a witness Region. ")
 		}
 		if numReplicasMRSC > 2 {
 			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency supports at most 2 replicas. ")
@@ -1504,6 +1529,8 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 
 		mrscInput = awstypes.MultiRegionConsistencyStrong
 		useMRSC = true
+	} else if witnessRegion != "" {
+		return fmt.Errorf("creating replicas: A witness Region requires replicas to use 'consistency_mode' set to 'STRONG' ")
 	}
 
 	// if MRSC or MREC is defined and meets the above criteria, then all replicas must be created in a single call to UpdateTable.
@@ -1536,6 +1563,17 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			MultiRegionConsistency: mrscInput,
 		}
 
+		// A witness must be created in the same request as the replica it supports.
+		if witnessRegion != "" {
+			input.GlobalTableWitnessUpdates = []awstypes.GlobalTableWitnessGroupUpdate{
+				{
+					Create: &awstypes.CreateGlobalTableWitnessGroupMemberAction{
+						RegionName: aws.String(witnessRegion),
+					},
+				},
+			}
+		}
+
 		err := tfresource.Retry(ctx, max(replicaUpdateTimeout, timeout), func(ctx context.Context) *tfresource.RetryError {
 			_, err := conn.UpdateTable(ctx, input)
 			if err != nil {
@@ -1577,6 +1615,12 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 				return fmt.Errorf("updating replica (%s) point in time recovery: %w", tfMap["region_name"].(string), err)
 			}
 		}
+
+		if witnessRegion != "" {
+			if _, err := waitGlobalTableWitnessActive(ctx, conn, tableName, witnessRegion, timeout); err != nil {
+				return fmt.Errorf("waiting for witness (%s) creation: %w", witnessRegion, err)
+			}
+		}
 	} else {
 		for _, tfMapRaw := range tfList {
 			tfMap, ok := tfMapRaw.(map[string]any)
@@ -1655,7 +1699,7 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			// ValidationException: One or more parameter values were invalid: KMSMasterKeyId must be specified for each replica.
 
 			if create && tfawserr.ErrMessageContains(err, errCodeValidationException, "already exist") {
-				return createReplicas(ctx, conn, tableName, tfList, false, timeout)
+				return createReplicas(ctx, conn, tableName, tfList, "", false, timeout)
 			}
 
 			if err != nil && !tfawserr.ErrMessageContains(err, errCodeValidationException, "no actions specified") {
@@ -1807,6 +1851,27 @@ func updateReplica(ctx context.Context, conn *dynamodb.Client, d *schema.Resourc
 	o := oRaw.(*schema.Set)
 	n := nRaw.(*schema.Set)
 
+	oWitnessRaw, nWitnessRaw := d.GetChange("global_table_witness")
+	oWitnessRegion, nWitnessRegion := globalTableWitnessRegionName(oWitnessRaw.([]any)), globalTableWitnessRegionName(nWitnessRaw.([]any))
+
+	// A witness can only be added or removed in the same request as the replicas it supports,
+	// so a witness change recreates all replicas (but not the table).
+	if oWitnessRegion != nWitnessRegion {
+		if o.Len() > 0 {
+			if err := deleteReplicas(ctx, conn, d.Id(), o.List(), oWitnessRegion, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("updating replicas, while deleting: %w", err)
+			}
+		}
+
+		if n.Len() > 0 {
+			if err := createReplicas(ctx, conn, d.Id(), n.List(), nWitnessRegion, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return fmt.Errorf("updating replicas, while creating: %w", err)
+			}
+		}
+
+		return nil
+	}
+
 	removeRaw := o.Difference(n).List()
 	addRaw := n.Difference(o).List()
 
@@ -1899,19 +1964,19 @@ func updateReplica(ctx context.Context, conn *dynamodb.Client, d *schema.Resourc
 	}
 
 	if len(removeFirst) > 0 { // mini ForceNew, recreates replica but doesn't recreate the table
-		if err := deleteReplicas(ctx, conn, d.Id(), removeFirst, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := deleteReplicas(ctx, conn, d.Id(), removeFirst, "", d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("updating replicas, while deleting: %w", err)
 		}
 	}
 
 	if len(toRemove) > 0 {
-		if err := deleteReplicas(ctx, conn, d.Id(), toRemove, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := deleteReplicas(ctx, conn, d.Id(), toRemove, "", d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("updating replicas, while deleting: %w", err)
 		}
 	}
 
 	if len(toAdd) > 0 {
-		if err := createReplicas(ctx, conn, d.Id(), toAdd, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+		if err := createReplicas(ctx, conn, d.Id(), toAdd, "", true, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("updating replicas, while creating: %w", err)
 		}
 	}
@@ -2164,7 +2229,7 @@ func deleteTable(ctx context.Context, conn *dynamodb.Client, tableName string) e
 	return err
 }
 
-func deleteReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []any, timeout time.Duration) error {
+func deleteReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []any, witnessRegion string, timeout time.Duration) error {
 	var g multierror.Group
 
 	var replicaDeletes []awstypes.ReplicationGroupUpdate
@@ -2203,6 +2268,17 @@ func deleteReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			TableName:      aws.String(tableName),
 			ReplicaUpdates: replicaDeletes,
 		}
+
+		if witnessRegion != "" {
+			input.GlobalTableWitnessUpdates = []awstypes.GlobalTableWitnessGroupUpdate{
+				{
+					Delete: &awstypes.DeleteGlobalTableWitnessGroupMemberAction{
+						RegionName: aws.String(witnessRegion),
+					},
+				},
+			}
+		}
+
 		err := tfresource.Retry(ctx, updateTableTimeout, func(ctx context.Context) *tfresource.RetryError {
 			_, err := conn.UpdateTable(ctx, input)
 			notFoundRetries := 0
@@ -2243,6 +2319,12 @@ func deleteReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 				return fmt.Errorf("waiting for replica (%s) deletion: %w", regionName, err)
 			}
 		}
+
+		if witnessRegion != "" {
+			if _, err := waitGlobalTableWitnessDeleted(ctx, conn, tableName, witnessRegion, timeout); err != nil {
+				return fmt.Errorf("waiting for witness (%s) deletion: %w", witnessRegion, err)
+			}
+		}
 		return nil
 	} else {
 		for _, tfMapRaw := range tfList {
@@ -2726,6 +2808,35 @@ func flattenReplicaDescriptions(apiObjects []awstypes.ReplicaDescription) []any
 	return tfList
 }
 
+func flattenGlobalTableWitnessDescriptions(apiObjects []awstypes.GlobalTableWitnessDescription) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"region_name":    aws.ToString(apiObject.RegionName),
+			names.AttrStatus: apiObject.WitnessStatus,
+		})
+	}
+
+	return tfList
+}
+
+func globalTableWitnessRegionName(tfList []any) string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return ""
+	}
+
+	if v, ok := tfList[0].(map[string]any)["region_name"].(string); ok {
+		return v
+	}
+
+	return ""
+}
+
 func flattenTTL(apiObject *dynamodb.DescribeTimeToLiveOutput) []any {
 	tfMap := map[string]any{
 		names.AttrEnabled: false,
@@ -3162,6 +3273,49 @@ func suppressGSIWarmThroughputDefaults(d *schema.ResourceDiff, configRaw cty.Val
 	return nil
 }
 
+func validateReplicaMultiRegionConsistencyCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.GetRawPlan().GetAttr("replica").IsWhollyKnown() || !d.GetRawPlan().GetAttr("global_table_witness").IsWhollyKnown() {
+		return nil
+	}
+
+	replicas := d.Get("replica").(*schema.Set).List()
+	witnessRegion := globalTableWitnessRegionName(d.Get("global_table_witness").([]any))
+
+	var numReplicasMRSC int
+	for _, tfMapRaw := range replicas {
+		tfMap := tfMapRaw.(map[string]any)
+
+		if awstypes.MultiRegionConsistency(tfMap["consistency_mode"].(string)) == awstypes.MultiRegionConsistencyStrong {
+			numReplicasMRSC++
+		}
+
+		if witnessRegion != "" && tfMap["region_name"].(string) == witnessRegion {
+			return fmt.Errorf("global_table_witness region_name (%s) must not also be a replica region_name", witnessRegion)
+		}
+	}
+
+	if witnessRegion != "" && witnessRegion == meta.(*conns.AWSClient).Region(ctx) {
+		return fmt.Errorf("global_table_witness region_name (%s) must not be the table's own Region", witnessRegion)
+	}
+
+	switch {
+	case numReplicasMRSC == 0:
+		if witnessRegion != "" {
+			return errors.New("global_table_witness requires replicas to use 'consistency_mode' set to 'STRONG'")
+		}
+	case numReplicasMRSC != len(replicas):
+		return errors.New("Using MultiRegionStrongConsistency requires all replicas to use 'consistency_mode' set to 'STRONG'")
+	case witnessRegion != "" && numReplicasMRSC != 1:
+		return errors.New("Using MultiRegionStrongConsistency with a global_table_witness requires exactly 1 replica")
+	case numReplicasMRSC > 2:
+		return errors.New("Using MultiRegionStrongConsistency supports at most 2 replicas")
+	case witnessRegion == "" && numReplicasMRSC != 2:
+		return errors.New("Using MultiRegionStrongConsistency requires exactly 2 replicas, or 1 replica and a global_table_witness")
+	}
+
+	return nil
+}
+
 func validateTTLCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	var diags diag.Diagnostics
 
@@ -78,6 +78,14 @@ func resourceTableExport() *schema.Resource {
 				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.ExportType](),
 			},
+			"failure_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"incremental_export_specification": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -249,6 +257,8 @@ func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta a
 		d.Set("export_time", aws.ToTime(desc.ExportTime).Format(time.RFC3339))
 	}
 	d.Set("export_type", desc.ExportType)
+	d.Set("failure_code", desc.FailureCode)
+	d.Set("failure_message", desc.FailureMessage)
 	d.Set("incremental_export_specification", flattenIncrementalExportSpecification(desc.IncrementalExportSpecification))
 	d.Set("item_count", desc.ItemCount)
 	d.Set("manifest_files_s3_key", desc.ExportManifest)
@@ -45,6 +45,8 @@ func TestAccDynamoDBTableExport_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "export_format", "DYNAMODB_JSON"),
 					resource.TestCheckResourceAttr(resourceName, "export_status", "COMPLETED"),
 					resource.TestCheckResourceAttr(resourceName, "export_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "failure_code", ""),
+					resource.TestCheckResourceAttr(resourceName, "failure_message", ""),
 					resource.TestCheckResourceAttr(resourceName, "incremental_export_specification.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "item_count", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrS3Bucket, s3BucketResourceName, names.AttrID),
@@ -134,6 +134,41 @@ func waitReplicaDeleted(ctx context.Context, conn *dynamodb.Client, tableName, r
 	return nil, err
 }
 
+func waitGlobalTableWitnessActive(ctx context.Context, conn *dynamodb.Client, tableName, region string, timeout time.Duration) (*awstypes.TableDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.WitnessStatusCreating),
+		Target:         enum.Slice(awstypes.WitnessStatusActive),
+		Refresh:        statusGlobalTableWitness(ctx, conn, tableName, region),
+		Timeout:        max(replicaUpdateTimeout, timeout),
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalTableWitnessDeleted(ctx context.Context, conn *dynamodb.Client, tableName, region string, timeout time.Duration) (*awstypes.TableDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WitnessStatusCreating, awstypes.WitnessStatusDeleting, awstypes.WitnessStatusActive),
+		Target:  []string{},
+		Refresh: statusGlobalTableWitness(ctx, conn, tableName, region),
+		Timeout: max(replicaUpdateTimeout, timeout),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGSIActive(ctx context.Context, conn *dynamodb.Client, tableName, indexName string, timeout time.Duration) (*awstypes.GlobalSecondaryIndexDescription, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.IndexStatusCreating, awstypes.IndexStatusUpdating),
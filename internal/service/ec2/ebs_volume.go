@@ -52,6 +52,7 @@ func resourceEBSVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attachments": volumeAttachmentsSchema(),
 			names.AttrAvailabilityZone: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -213,6 +214,9 @@ func resourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	d.Set(names.AttrARN, ebsVolumeARN(ctx, c, d.Id()))
+	if err := d.Set("attachments", flattenVolumeAttachments(volume.Attachments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attachments: %s", err)
+	}
 	d.Set(names.AttrAvailabilityZone, volume.AvailabilityZone)
 	d.Set(names.AttrCreateTime, volume.CreateTime.Format(time.RFC3339))
 	d.Set(names.AttrEncrypted, volume.Encrypted)
@@ -399,3 +403,59 @@ func resourceEBSVolumeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff
 func ebsVolumeARN(ctx context.Context, c *conns.AWSClient, volumeID string) string {
 	return c.RegionalARN(ctx, names.EC2, "volume/"+volumeID)
 }
+
+func volumeAttachmentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attach_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrDeleteOnTermination: {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				names.AttrDeviceName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrInstanceID: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrState: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenVolumeAttachments(apiObjects []awstypes.VolumeAttachment) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrDeleteOnTermination: aws.ToBool(apiObject.DeleteOnTermination),
+			names.AttrDeviceName:          aws.ToString(apiObject.Device),
+			names.AttrInstanceID:          aws.ToString(apiObject.InstanceId),
+			names.AttrState:               apiObject.State,
+		}
+
+		if v := apiObject.AttachTime; v != nil {
+			tfMap["attach_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
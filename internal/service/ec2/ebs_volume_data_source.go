@@ -35,6 +35,7 @@ func dataSourceEBSVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attachments": volumeAttachmentsSchema(),
 			names.AttrAvailabilityZone: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -141,6 +142,9 @@ func dataSourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta a
 
 	d.SetId(aws.ToString(volume.VolumeId))
 	d.Set(names.AttrARN, ebsVolumeARN(ctx, c, d.Id()))
+	if err := d.Set("attachments", flattenVolumeAttachments(volume.Attachments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attachments: %s", err)
+	}
 	d.Set(names.AttrAvailabilityZone, volume.AvailabilityZone)
 	d.Set(names.AttrCreateTime, volume.CreateTime.Format(time.RFC3339))
 	d.Set(names.AttrEncrypted, volume.Encrypted)
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_allowed_images_settings", name="Allowed Images Settings")
+func newAllowedImagesSettingsResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &allowedImagesSettingsResource{}
+
+	return r, nil
+}
+
+type allowedImagesSettingsResource struct {
+	framework.ResourceWithModel[allowedImagesSettingsResourceModel]
+	framework.WithImportByID
+}
+
+func (r *allowedImagesSettingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"managed_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AllowedImagesSettingsEnabledState](),
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"image_criterion": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[imageCriterionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"image_names": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"image_providers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"marketplace_product_codes": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"creation_date_condition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[creationDateConditionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"maximum_days_since_created": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 2147483647),
+										},
+									},
+								},
+							},
+						},
+						"deprecation_time_condition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[deprecationTimeConditionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"maximum_days_since_deprecated": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 2147483647),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *allowedImagesSettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data allowedImagesSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Replace the image criteria before enabling, so that the allowlist is in place as soon as it is enforced.
+	if !data.ImageCriteria.IsNull() {
+		if err := replaceImageCriteriaInAllowedImagesSettings(ctx, conn, data); err != nil {
+			response.Diagnostics.AddError("creating EC2 Allowed Images Settings", err.Error())
+
+			return
+		}
+	}
+
+	input := ec2.EnableAllowedImagesSettingsInput{
+		AllowedImagesSettingsState: data.State.ValueEnum(),
+	}
+
+	_, err := conn.EnableAllowedImagesSettings(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating EC2 Allowed Images Settings", err.Error())
+
+		return
+	}
+
+	output, err := findAllowedImagesSettings(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Allowed Images Settings", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID(ctx))
+	data.ManagedBy = fwflex.StringValueToFramework(ctx, output.ManagedBy)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *allowedImagesSettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data allowedImagesSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findAllowedImagesSettings(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Allowed Images Settings", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *allowedImagesSettingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old allowedImagesSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if !new.ImageCriteria.Equal(old.ImageCriteria) {
+		if err := replaceImageCriteriaInAllowedImagesSettings(ctx, conn, new); err != nil {
+			response.Diagnostics.AddError("updating EC2 Allowed Images Settings", err.Error())
+
+			return
+		}
+	}
+
+	if !new.State.Equal(old.State) {
+		input := ec2.EnableAllowedImagesSettingsInput{
+			AllowedImagesSettingsState: new.State.ValueEnum(),
+		}
+
+		_, err := conn.EnableAllowedImagesSettings(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError("updating EC2 Allowed Images Settings", err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *allowedImagesSettingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	input := ec2.DisableAllowedImagesSettingsInput{}
+	_, err := conn.DisableAllowedImagesSettings(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("deleting EC2 Allowed Images Settings", err.Error())
+
+		return
+	}
+}
+
+func replaceImageCriteriaInAllowedImagesSettings(ctx context.Context, conn *ec2.Client, data allowedImagesSettingsResourceModel) error {
+	input := ec2.ReplaceImageCriteriaInAllowedImagesSettingsInput{}
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	_, err := conn.ReplaceImageCriteriaInAllowedImagesSettings(ctx, &input)
+
+	return err
+}
+
+type allowedImagesSettingsResourceModel struct {
+	framework.WithRegionModel
+	ID            types.String                                                   `tfsdk:"id"`
+	ImageCriteria fwtypes.ListNestedObjectValueOf[imageCriterionModel]           `tfsdk:"image_criterion"`
+	ManagedBy     types.String                                                   `tfsdk:"managed_by"`
+	State         fwtypes.StringEnum[awstypes.AllowedImagesSettingsEnabledState] `tfsdk:"state"`
+}
+
+type imageCriterionModel struct {
+	CreationDateCondition    fwtypes.ListNestedObjectValueOf[creationDateConditionModel]    `tfsdk:"creation_date_condition"`
+	DeprecationTimeCondition fwtypes.ListNestedObjectValueOf[deprecationTimeConditionModel] `tfsdk:"deprecation_time_condition"`
+	ImageNames               fwtypes.SetOfString                                            `tfsdk:"image_names"`
+	ImageProviders           fwtypes.SetOfString                                            `tfsdk:"image_providers"`
+	MarketplaceProductCodes  fwtypes.SetOfString                                            `tfsdk:"marketplace_product_codes"`
+}
+
+type creationDateConditionModel struct {
+	MaximumDaysSinceCreated types.Int64 `tfsdk:"maximum_days_since_created"`
+}
+
+type deprecationTimeConditionModel struct {
+	MaximumDaysSinceDeprecated types.Int64 `tfsdk:"maximum_days_since_deprecated"`
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2AllowedImagesSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccAllowedImagesSettings_basic,
+		acctest.CtDisappears: testAccAllowedImagesSettings_disappears,
+		"criteria":           testAccAllowedImagesSettings_criteria,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAllowedImagesSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_allowed_images_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowedImagesSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowedImagesSettingsConfig_basic("audit-mode"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAllowedImagesSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.0.image_providers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_criterion.0.image_providers.*", "amazon"),
+					resource.TestCheckResourceAttr(resourceName, "managed_by", "account"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "audit-mode"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowedImagesSettingsConfig_basic(names.AttrEnabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAllowedImagesSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, names.AttrEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccAllowedImagesSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_allowed_images_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowedImagesSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowedImagesSettingsConfig_basic("audit-mode"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAllowedImagesSettingsExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceAllowedImagesSettings, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAllowedImagesSettings_criteria(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_allowed_images_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowedImagesSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowedImagesSettingsConfig_criteria,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAllowedImagesSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.0.image_providers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.0.creation_date_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.0.creation_date_condition.0.maximum_days_since_created", "365"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.1.image_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_criterion.1.image_names.*", "al2023-ami-*"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.1.deprecation_time_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.1.deprecation_time_condition.0.maximum_days_since_deprecated", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "audit-mode"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowedImagesSettingsConfig_basic("audit-mode"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAllowedImagesSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.0.creation_date_condition.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAllowedImagesSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_allowed_images_settings" {
+				continue
+			}
+
+			_, err := tfec2.FindAllowedImagesSettings(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Allowed Images Settings %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAllowedImagesSettingsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindAllowedImagesSettings(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccAllowedImagesSettingsConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_allowed_images_settings" "test" {
+  state = %[1]q
+
+  image_criterion {
+    image_providers = ["amazon"]
+  }
+}
+`, state)
+}
+
+const testAccAllowedImagesSettingsConfig_criteria = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_ec2_allowed_images_settings" "test" {
+  state = "audit-mode"
+
+  image_criterion {
+    image_providers = ["amazon", data.aws_caller_identity.current.account_id]
+
+    creation_date_condition {
+      maximum_days_since_created = 365
+    }
+  }
+
+  image_criterion {
+    image_names = ["al2023-ami-*"]
+
+    deprecation_time_condition {
+      maximum_days_since_deprecated = 0
+    }
+  }
+}
+`
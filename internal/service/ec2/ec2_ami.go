@@ -84,6 +84,25 @@ func resourceAMI() *schema.Resource {
 				DiffSuppressFunc:      sdkv2.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -395,6 +414,12 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		if err := updateImageDeregistrationProtection(ctx, conn, d.Id(), v.([]any)[0].(map[string]any)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -435,6 +460,9 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	d.Set("boot_mode", image.BootMode)
 	d.Set(names.AttrDescription, image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	if err := d.Set("deregistration_protection", flattenImageDeregistrationProtection(aws.ToString(image.DeregistrationProtection))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deregistration_protection: %s", err)
+	}
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -500,6 +528,14 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 		}
 	}
 
+	if d.HasChange("deregistration_protection") {
+		if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			if err := updateImageDeregistrationProtection(ctx, conn, d.Id(), v.([]any)[0].(map[string]any)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -620,6 +656,48 @@ func disableImageDeprecation(ctx context.Context, conn *ec2.Client, id string) e
 	return nil
 }
 
+func updateImageDeregistrationProtection(ctx context.Context, conn *ec2.Client, id string, tfMap map[string]any) error {
+	if !tfMap[names.AttrEnabled].(bool) {
+		input := ec2.DisableImageDeregistrationProtectionInput{
+			ImageId: aws.String(id),
+		}
+
+		_, err := conn.DisableImageDeregistrationProtection(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("disabling deregistration protection: %w", err)
+		}
+
+		return nil
+	}
+
+	input := ec2.EnableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+	if v, ok := tfMap["with_cooldown"].(bool); ok {
+		input.WithCooldown = aws.Bool(v)
+	}
+
+	_, err := conn.EnableImageDeregistrationProtection(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: %w", err)
+	}
+
+	return nil
+}
+
+// flattenImageDeregistrationProtection converts the image's DeregistrationProtection value
+// ("disabled", "enabled", "enabled-with-cooldown" or "disabled-until <timestamp>") into
+// the deregistration_protection block. Protection that is pending removal after its
+// cooldown period is reported as disabled.
+func flattenImageDeregistrationProtection(v string) []any {
+	return []any{map[string]any{
+		names.AttrEnabled: strings.HasPrefix(v, "enabled"),
+		"with_cooldown":   strings.HasSuffix(v, "with-cooldown"),
+	}}
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]any) awstypes.BlockDeviceMapping {
 	apiObject := awstypes.BlockDeviceMapping{
 		Ebs: &awstypes.EbsBlockDevice{},
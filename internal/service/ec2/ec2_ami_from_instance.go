@@ -12,7 +12,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -55,6 +58,12 @@ func resourceAMIFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"copy_tags_to_snapshots": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"deprecation_time": {
 				Type:                  schema.TypeString,
 				Optional:              true,
@@ -62,6 +71,25 @@ func resourceAMIFromInstance() *schema.Resource {
 				DiffSuppressFunc:      sdkv2.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -177,6 +205,12 @@ func resourceAMIFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrKMSKeyID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"last_launched_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -187,7 +221,8 @@ func resourceAMIFromInstance() *schema.Resource {
 			// be independently managed.
 			"manage_ebs_snapshots": {
 				Type:     schema.TypeBool,
-				Computed: true,
+				Optional: true,
+				Default:  true,
 			},
 			names.AttrName: {
 				Type:     schema.TypeString,
@@ -260,16 +295,31 @@ func resourceAMIFromInstance() *schema.Resource {
 
 func resourceAMIFromInstanceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Client(ctx)
 
 	instanceID := d.Get("source_instance_id").(string)
 	name := d.Get(names.AttrName).(string)
+	description := d.Get(names.AttrDescription).(string)
+	tagSpecifications := getTagSpecificationsIn(ctx, awstypes.ResourceTypeImage)
+	if d.Get("copy_tags_to_snapshots").(bool) {
+		tagSpecifications = append(tagSpecifications, getTagSpecificationsIn(ctx, awstypes.ResourceTypeSnapshot)...)
+	}
+	kmsKeyID, encrypt := d.GetOk(names.AttrKMSKeyID)
+
 	input := ec2.CreateImageInput{
-		Description:       aws.String(d.Get(names.AttrDescription).(string)),
-		InstanceId:        aws.String(instanceID),
-		Name:              aws.String(name),
-		NoReboot:          aws.Bool(d.Get("snapshot_without_reboot").(bool)),
-		TagSpecifications: getTagSpecificationsIn(ctx, awstypes.ResourceTypeImage),
+		Description: aws.String(description),
+		InstanceId:  aws.String(instanceID),
+		Name:        aws.String(name),
+		NoReboot:    aws.Bool(d.Get("snapshot_without_reboot").(bool)),
+	}
+
+	if encrypt {
+		// CreateImage can't encrypt snapshots with a specific key, so build an intermediate
+		// AMI and copy it to the final, encrypted AMI.
+		input.Name = aws.String(id.PrefixedUniqueId("terraform-intermediate-"))
+	} else {
+		input.TagSpecifications = tagSpecifications
 	}
 
 	output, err := conn.CreateImage(ctx, &input)
@@ -278,11 +328,56 @@ func resourceAMIFromInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): %s", name, instanceID, err)
 	}
 
-	d.SetId(aws.ToString(output.ImageId))
-	d.Set("manage_ebs_snapshots", true)
+	imageID := aws.ToString(output.ImageId)
+
+	if !encrypt {
+		d.SetId(imageID)
+	}
+
+	image, err := waitImageAvailable(ctx, conn, imageID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): waiting for completion: %s", name, instanceID, err)
+
+		// The intermediate AMI is never recorded in state.
+		if encrypt && image != nil {
+			diags = append(diags, deregisterImageAndDeleteSnapshots(ctx, conn, image, d.Timeout(schema.TimeoutDelete))...)
+		}
+
+		return diags
+	}
+
+	if encrypt {
+		input := ec2.CopyImageInput{
+			ClientToken:       aws.String(id.UniqueId()),
+			Description:       aws.String(description),
+			Encrypted:         aws.Bool(true),
+			KmsKeyId:          aws.String(kmsKeyID.(string)),
+			Name:              aws.String(name),
+			SourceImageId:     aws.String(imageID),
+			SourceRegion:      aws.String(c.Region(ctx)),
+			TagSpecifications: tagSpecifications,
+		}
+
+		output, err := conn.CopyImage(ctx, &input)
 
-	if _, err := waitImageAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): waiting for completion: %s", name, instanceID, err)
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): copying intermediate AMI (%s): %s", name, instanceID, imageID, err)
+
+			return append(diags, deregisterImageAndDeleteSnapshots(ctx, conn, image, d.Timeout(schema.TimeoutDelete))...)
+		}
+
+		d.SetId(aws.ToString(output.ImageId))
+
+		if _, err := waitImageAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): waiting for completion: %s", name, instanceID, err)
+
+			return append(diags, deregisterImageAndDeleteSnapshots(ctx, conn, image, d.Timeout(schema.TimeoutDelete))...)
+		}
+
+		for _, v := range deregisterImageAndDeleteSnapshots(ctx, conn, image, d.Timeout(schema.TimeoutDelete)) {
+			diags = sdkdiag.AppendWarningf(diags, "cleaning up intermediate EC2 AMI (%s): %s", imageID, v.Summary)
+		}
 	}
 
 	if v, ok := d.GetOk("deprecation_time"); ok {
@@ -291,5 +386,56 @@ func resourceAMIFromInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		if err := updateImageDeregistrationProtection(ctx, conn, d.Id(), v.([]any)[0].(map[string]any)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): %s", name, instanceID, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
+
+// deregisterImageAndDeleteSnapshots deregisters the specified AMI and deletes its EBS snapshots.
+func deregisterImageAndDeleteSnapshots(ctx context.Context, conn *ec2.Client, image *awstypes.Image, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	imageID := aws.ToString(image.ImageId)
+
+	input := ec2.DeregisterImageInput{
+		ImageId: aws.String(imageID),
+	}
+	_, err := conn.DeregisterImage(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound, errCodeInvalidAMIIDUnavailable) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering EC2 AMI (%s): %s", imageID, err)
+	}
+
+	if _, err := waitImageDeleted(ctx, conn, imageID, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 AMI (%s) delete: %s", imageID, err)
+	}
+
+	for _, v := range image.BlockDeviceMappings {
+		if v.Ebs == nil || v.Ebs.SnapshotId == nil {
+			continue
+		}
+
+		snapshotID := aws.ToString(v.Ebs.SnapshotId)
+		input := ec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(snapshotID),
+		}
+		_, err := conn.DeleteSnapshot(ctx, &input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+			continue
+		}
+
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "deleting EBS Snapshot (%s): %s", snapshotID, err)
+		}
+	}
+
+	return diags
+}
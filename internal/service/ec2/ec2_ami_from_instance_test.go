@@ -111,6 +111,39 @@ func TestAccEC2AMIFromInstance_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIFromInstance_encrypted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var image awstypes.Image
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ami_from_instance.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIFromInstanceConfig_encrypted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags_to_snapshots", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						names.AttrEncrypted: acctest.CtTrue,
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, kmsKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "manage_ebs_snapshots", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_without_reboot", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccAMIFromInstanceBaseConfig(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -171,3 +204,28 @@ resource "aws_ami_from_instance" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccAMIFromInstanceConfig_encrypted(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAMIFromInstanceBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_ami_from_instance" "test" {
+  name                    = %[1]q
+  description             = "Testing Terraform aws_ami_from_instance resource"
+  source_instance_id      = aws_instance.test.id
+  snapshot_without_reboot = true
+  copy_tags_to_snapshots  = true
+  kms_key_id              = aws_kms_key.test.arn
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
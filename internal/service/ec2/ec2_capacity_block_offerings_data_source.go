@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ec2_capacity_block_offerings", name="Capacity Block Offerings")
+func newCapacityBlockOfferingsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &capacityBlockOfferingsDataSource{}, nil
+}
+
+type capacityBlockOfferingsDataSource struct {
+	framework.DataSourceWithModel[capacityBlockOfferingsDataSourceModel]
+}
+
+func (d *capacityBlockOfferingsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"capacity_block_offerings": framework.DataSourceComputedListOfObjectAttribute[capacityBlockOfferingModel](ctx),
+			"capacity_duration_hours": schema.Int64Attribute{
+				Required: true,
+			},
+			"end_date_range": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrInstanceCount: schema.Int64Attribute{
+				Required: true,
+			},
+			names.AttrInstanceType: schema.StringAttribute{
+				Required: true,
+			},
+			"start_date_range": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *capacityBlockOfferingsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data capacityBlockOfferingsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	var input ec2.DescribeCapacityBlockOfferingsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findCapacityBlockOfferings(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Capacity Block Offerings (%s)", data.InstanceType.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.CapacityBlockOfferings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type capacityBlockOfferingsDataSourceModel struct {
+	framework.WithRegionModel
+	CapacityBlockOfferings fwtypes.ListNestedObjectValueOf[capacityBlockOfferingModel] `tfsdk:"capacity_block_offerings"`
+	CapacityDurationHours  types.Int64                                                 `tfsdk:"capacity_duration_hours"`
+	EndDateRange           timetypes.RFC3339                                           `tfsdk:"end_date_range"`
+	InstanceCount          types.Int64                                                 `tfsdk:"instance_count"`
+	InstanceType           types.String                                                `tfsdk:"instance_type"`
+	StartDateRange         timetypes.RFC3339                                           `tfsdk:"start_date_range"`
+}
+
+type capacityBlockOfferingModel struct {
+	AvailabilityZone           types.String      `tfsdk:"availability_zone"`
+	CapacityBlockDurationHours types.Int64       `tfsdk:"capacity_block_duration_hours"`
+	CapacityBlockOfferingID    types.String      `tfsdk:"capacity_block_offering_id"`
+	CurrencyCode               types.String      `tfsdk:"currency_code"`
+	EndDate                    timetypes.RFC3339 `tfsdk:"end_date"`
+	InstanceCount              types.Int64       `tfsdk:"instance_count"`
+	InstanceType               types.String      `tfsdk:"instance_type"`
+	StartDate                  timetypes.RFC3339 `tfsdk:"start_date"`
+	Tenancy                    types.String      `tfsdk:"tenancy"`
+	UpfrontFee                 types.String      `tfsdk:"upfront_fee"`
+}
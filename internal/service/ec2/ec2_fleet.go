@@ -1251,7 +1251,7 @@ func expandFleetSpotCapacityRebalanceRequest(tfMap map[string]any) *awstypes.Fle
 		apiObject.ReplacementStrategy = awstypes.FleetReplacementStrategy(v)
 	}
 
-	if v, ok := tfMap["termination_delay"].(int); ok {
+	if v, ok := tfMap["termination_delay"].(int); ok && v != 0 {
 		apiObject.TerminationDelay = aws.Int32(int32(v))
 	}
 
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_fleet_instances", name="Fleet Instances")
+func dataSourceFleetInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFleetInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"fleet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"fleet_id", "spot_fleet_request_id"},
+			},
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_health": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spot_instance_request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"spot_fleet_request_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"fleet_id", "spot_fleet_request_id"},
+			},
+		},
+	}
+}
+
+func dataSourceFleetInstancesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	var id string
+	var instances []awstypes.ActiveInstance
+
+	if v, ok := d.GetOk("fleet_id"); ok {
+		id = v.(string)
+		input := ec2.DescribeFleetInstancesInput{
+			FleetId: aws.String(id),
+		}
+
+		output, err := findFleetInstances(ctx, conn, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Fleet (%s) instances: %s", id, err)
+		}
+
+		instances = output
+	} else {
+		id = d.Get("spot_fleet_request_id").(string)
+		input := ec2.DescribeSpotFleetInstancesInput{
+			SpotFleetRequestId: aws.String(id),
+		}
+
+		output, err := findSpotFleetInstances(ctx, conn, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Fleet Request (%s) instances: %s", id, err)
+		}
+
+		instances = output
+	}
+
+	d.SetId(id)
+	d.Set("instance_ids", tfslices.ApplyToAll(instances, func(v awstypes.ActiveInstance) string {
+		return aws.ToString(v.InstanceId)
+	}))
+	if err := d.Set("instances", flattenActiveInstances(instances)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+	}
+
+	return diags
+}
+
+func flattenActiveInstances(apiObjects []awstypes.ActiveInstance) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"instance_health":          apiObject.InstanceHealth,
+			names.AttrInstanceID:       aws.ToString(apiObject.InstanceId),
+			names.AttrInstanceType:     aws.ToString(apiObject.InstanceType),
+			"spot_instance_request_id": aws.ToString(apiObject.SpotInstanceRequestId),
+		})
+	}
+
+	return tfList
+}
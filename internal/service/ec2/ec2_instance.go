@@ -76,6 +76,7 @@ func resourceInstance() *schema.Resource {
 					return nil, err
 				}
 
+				rd.Set("allow_stop_for_update", false)
 				rd.Set(names.AttrForceDestroy, false)
 				return []*schema.ResourceData{rd}, nil
 			},
@@ -99,6 +100,11 @@ func resourceInstance() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_stop_for_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"ami": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
@@ -185,13 +191,11 @@ func resourceInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 						"threads_per_core": {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -323,7 +327,11 @@ func resourceInstance() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+			},
+			"ena_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"enclave_options": {
 				Type:     schema.TypeList,
@@ -793,6 +801,12 @@ func resourceInstance() *schema.Resource {
 							Optional: true,
 							Computed: true,
 						},
+						"volume_initialization_rate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(100, 300),
+						},
 						names.AttrVolumeType: {
 							Type:             schema.TypeString,
 							Optional:         true,
@@ -999,6 +1013,11 @@ func resourceInstance() *schema.Resource {
 				o, n := diff.GetChange("enable_primary_ipv6")
 				return o.(bool) && !n.(bool) // can be enabled but not disabled without recreate
 			}),
+			// Modifying these attributes requires the instance to be stopped.
+			customdiff.ForceNewIf("cpu_options.0.core_count", forceNewUnlessStopForUpdateAllowed),
+			customdiff.ForceNewIf("cpu_options.0.threads_per_core", forceNewUnlessStopForUpdateAllowed),
+			customdiff.ForceNewIf("ebs_optimized", forceNewUnlessStopForUpdateAllowed),
+			customdiff.ForceNewIf("ena_support", forceNewUnlessStopForUpdateAllowed),
 			customdiff.ForceNewIf(names.AttrInstanceType, func(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
 				conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
@@ -1227,6 +1246,20 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	// ENA support can't be specified at launch.
+	if v := d.GetRawConfig().GetAttr("ena_support"); v.IsKnown() && !v.IsNull() && v.True() != aws.ToBool(instance.EnaSupport) {
+		input := ec2.ModifyInstanceAttributeInput{
+			EnaSupport: &awstypes.AttributeBooleanValue{
+				Value: aws.Bool(v.True()),
+			},
+			InstanceId: aws.String(d.Id()),
+		}
+
+		if err := modifyInstanceAttributeWithStopStart(ctx, conn, &input, "EnaSupport"); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) ENA support: %s", d.Id(), err)
+		}
+	}
+
 	// Update if we need to
 	return append(diags, resourceInstanceUpdate(ctx, d, meta)...)
 }
@@ -1619,6 +1652,45 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	// See also CustomizeDiff.
+	if d.HasChanges("cpu_options.0.core_count", "cpu_options.0.threads_per_core") && !d.IsNewResource() {
+		input := ec2.ModifyInstanceCpuOptionsInput{
+			CoreCount:      aws.Int32(int32(d.Get("cpu_options.0.core_count").(int))),
+			InstanceId:     aws.String(d.Id()),
+			ThreadsPerCore: aws.Int32(int32(d.Get("cpu_options.0.threads_per_core").(int))),
+		}
+
+		if err := modifyInstanceCPUOptionsWithStopStart(ctx, conn, &input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) CPU options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ebs_optimized") && !d.IsNewResource() {
+		input := ec2.ModifyInstanceAttributeInput{
+			EbsOptimized: &awstypes.AttributeBooleanValue{
+				Value: aws.Bool(d.Get("ebs_optimized").(bool)),
+			},
+			InstanceId: aws.String(d.Id()),
+		}
+
+		if err := modifyInstanceAttributeWithStopStart(ctx, conn, &input, "EbsOptimized"); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) EBS optimization: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ena_support") && !d.IsNewResource() {
+		input := ec2.ModifyInstanceAttributeInput{
+			EnaSupport: &awstypes.AttributeBooleanValue{
+				Value: aws.Bool(d.Get("ena_support").(bool)),
+			},
+			InstanceId: aws.String(d.Id()),
+		}
+
+		if err := modifyInstanceAttributeWithStopStart(ctx, conn, &input, "EnaSupport"); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) ENA support: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("disable_api_stop") && !d.IsNewResource() {
 		if err := disableInstanceAPIStop(ctx, conn, d.Id(), d.Get("disable_api_stop").(bool)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
@@ -2021,6 +2093,33 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.Client,
 	return nil
 }
 
+// modifyInstanceCPUOptionsWithStopStart modifies an EC2 instance's CPU options
+// by first stopping the EC2 instance before the modification
+// and then starting up the EC2 instance after modification.
+func modifyInstanceCPUOptionsWithStopStart(ctx context.Context, conn *ec2.Client, input *ec2.ModifyInstanceCpuOptionsInput) error {
+	id := aws.ToString(input.InstanceId)
+
+	if err := stopInstance(ctx, conn, id, false, instanceStopTimeout); err != nil {
+		return err
+	}
+
+	if _, err := conn.ModifyInstanceCpuOptions(ctx, input); err != nil {
+		return fmt.Errorf("modifying EC2 Instance (%s) CPU options: %w", id, err)
+	}
+
+	if err := startInstance(ctx, conn, id, true, instanceStartTimeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// forceNewUnlessStopForUpdateAllowed forces replacement of an existing instance when an attribute
+// that can only be modified while the instance is stopped changes and allow_stop_for_update is not set.
+func forceNewUnlessStopForUpdateAllowed(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
+	return diff.Id() != "" && !diff.Get("allow_stop_for_update").(bool)
+}
+
 func readBlockDevices(ctx context.Context, d *schema.ResourceData, meta *conns.AWSClient, instance *awstypes.Instance, ds bool) error {
 	ibds, err := readBlockDevicesFromInstance(ctx, d, meta, instance, ds)
 	if err != nil {
@@ -2039,6 +2138,8 @@ func readBlockDevices(ctx context.Context, d *schema.ResourceData, meta *conns.A
 					m := make(map[string]any)
 
 					maps.Copy(m, root)
+					// Not part of the ebs_block_device schema.
+					delete(m, "volume_initialization_rate")
 
 					if snapshotID, ok := ibds[names.AttrSnapshotID].(string); ok {
 						m[names.AttrSnapshotID] = snapshotID
@@ -2171,6 +2272,10 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, m
 		}
 
 		if blockDeviceIsRoot(instanceBd, instance) {
+			if vol.VolumeInitializationRate != nil {
+				bd["volume_initialization_rate"] = aws.ToInt32(vol.VolumeInitializationRate)
+			}
+
 			blockDevices["root"] = bd
 		} else {
 			if vol.SnapshotId != nil {
@@ -2459,6 +2564,10 @@ func readBlockDeviceMappingsFromConfig(ctx context.Context, d *schema.ResourceDa
 				ebs.KmsKeyId = aws.String(bd[names.AttrKMSKeyID].(string))
 			}
 
+			if v, ok := bd["volume_initialization_rate"].(int); ok && v != 0 {
+				ebs.VolumeInitializationRate = aws.Int32(int32(v))
+			}
+
 			if v, ok := bd[names.AttrVolumeSize].(int); ok && v != 0 {
 				ebs.VolumeSize = aws.Int32(int32(v))
 			}
@@ -3283,6 +3392,7 @@ func resourceInstanceFlatten(ctx context.Context, client *conns.AWSClient, insta
 	}
 
 	rd.Set("ebs_optimized", instance.EbsOptimized)
+	rd.Set("ena_support", instance.EnaSupport)
 	if aws.ToString(instance.SubnetId) != "" {
 		rd.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -39,6 +38,7 @@ func newInstanceConnectEndpointResource(context.Context) (resource.ResourceWithC
 	r := &instanceConnectEndpointResource{}
 
 	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
 	r.SetDefaultDeleteTimeout(10 * time.Minute)
 
 	return r, nil
@@ -96,9 +96,6 @@ func (r *instanceConnectEndpointResource) Schema(ctx context.Context, req resour
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},
 			names.AttrSecurityGroupIDs: schema.SetAttribute{
 				CustomType:  fwtypes.SetOfStringType,
@@ -106,7 +103,7 @@ func (r *instanceConnectEndpointResource) Schema(ctx context.Context, req resour
 				Computed:    true,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.Set{
-					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
 				},
 			},
 			names.AttrSubnetID: schema.StringAttribute{
@@ -127,6 +124,7 @@ func (r *instanceConnectEndpointResource) Schema(ctx context.Context, req resour
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -225,6 +223,64 @@ func (r *instanceConnectEndpointResource) Read(ctx context.Context, request reso
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *instanceConnectEndpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old instanceConnectEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := new.InstanceConnectEndpointID.ValueString()
+
+	if !new.PreserveClientIP.Equal(old.PreserveClientIP) || !new.SecurityGroupIDs.Equal(old.SecurityGroupIDs) {
+		input := ec2.ModifyInstanceConnectEndpointInput{
+			InstanceConnectEndpointId: aws.String(id),
+		}
+
+		if !new.PreserveClientIP.Equal(old.PreserveClientIP) {
+			input.PreserveClientIp = fwflex.BoolFromFramework(ctx, new.PreserveClientIP)
+		}
+
+		if !new.SecurityGroupIDs.Equal(old.SecurityGroupIDs) {
+			input.SecurityGroupIds = fwflex.ExpandFrameworkStringValueSet(ctx, new.SecurityGroupIDs)
+		}
+
+		_, err := conn.ModifyInstanceConnectEndpoint(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Instance Connect Endpoint (%s)", id), err.Error())
+
+			return
+		}
+
+		instanceConnectEndpoint, err := waitInstanceConnectEndpointUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Instance Connect Endpoint (%s) update", id), err.Error())
+
+			return
+		}
+
+		// Fix missing FipsDnsName in regions without FIPS endpoint support.
+		if instanceConnectEndpoint.FipsDnsName == nil {
+			instanceConnectEndpoint.FipsDnsName = aws.String("")
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, instanceConnectEndpoint, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
 func (r *instanceConnectEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data instanceConnectEndpointResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ec2_instance_connect_endpoint", name="Instance Connect Endpoint")
+func newInstanceConnectEndpointDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &instanceConnectEndpointDataSource{}
+
+	return d, nil
+}
+
+type instanceConnectEndpointDataSource struct {
+	framework.DataSourceWithModel[instanceConnectEndpointDataSourceModel]
+}
+
+func (d *instanceConnectEndpointDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDNSName: schema.StringAttribute{
+				Computed: true,
+			},
+			"fips_dns_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"network_interface_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrOwnerID: schema.StringAttribute{
+				Computed: true,
+			},
+			"preserve_client_ip": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrSecurityGroupIDs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrSubnetID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: customFiltersBlock(ctx),
+		},
+	}
+}
+
+func (d *instanceConnectEndpointDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data instanceConnectEndpointDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
+
+	input := ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"subnet-id": data.SubnetID.ValueString(),
+			"vpc-id":    data.VPCID.ValueString(),
+		}),
+	}
+
+	if !data.InstanceConnectEndpointID.IsNull() {
+		input.InstanceConnectEndpointIds = []string{data.InstanceConnectEndpointID.ValueString()}
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterListFramework(ctx, data.Filters)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	instanceConnectEndpoint, err := findInstanceConnectEndpoint(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Instance Connect Endpoint", tfresource.SingularDataSourceFindError("EC2 Instance Connect Endpoint", err).Error())
+
+		return
+	}
+
+	// Fix missing FipsDnsName in regions without FIPS endpoint support.
+	if instanceConnectEndpoint.FipsDnsName == nil {
+		instanceConnectEndpoint.FipsDnsName = aws.String("")
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, instanceConnectEndpoint, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Tags = tftags.FlattenStringValueMap(ctx, keyValueTags(ctx, instanceConnectEndpoint.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type instanceConnectEndpointDataSourceModel struct {
+	framework.WithRegionModel
+	AvailabilityZone           types.String         `tfsdk:"availability_zone"`
+	DNSName                    types.String         `tfsdk:"dns_name"`
+	Filters                    customFilters        `tfsdk:"filter"`
+	FipsDnsName                types.String         `tfsdk:"fips_dns_name"`
+	InstanceConnectEndpointARN types.String         `tfsdk:"arn"`
+	InstanceConnectEndpointID  types.String         `tfsdk:"id"`
+	NetworkInterfaceIDs        fwtypes.ListOfString `tfsdk:"network_interface_ids"`
+	OwnerID                    types.String         `tfsdk:"owner_id"`
+	PreserveClientIP           types.Bool           `tfsdk:"preserve_client_ip"`
+	SecurityGroupIDs           fwtypes.SetOfString  `tfsdk:"security_group_ids"`
+	State                      types.String         `tfsdk:"state"`
+	SubnetID                   types.String         `tfsdk:"subnet_id"`
+	Tags                       tftags.Map           `tfsdk:"tags"`
+	VPCID                      types.String         `tfsdk:"vpc_id"`
+}
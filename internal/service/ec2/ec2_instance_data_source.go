@@ -357,6 +357,10 @@ func dataSourceInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"volume_initialization_rate": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						names.AttrVolumeType: {
 							Type:     schema.TypeString,
 							Computed: true,
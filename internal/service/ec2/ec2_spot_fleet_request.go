@@ -803,6 +803,12 @@ func resourceSpotFleetRequest() *schema.Resource {
 										ForceNew:         true,
 										ValidateDiagFunc: enum.Validate[awstypes.ReplacementStrategy](),
 									},
+									"termination_delay": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(120, 7200),
+									},
 								},
 							},
 						},
@@ -1846,6 +1852,10 @@ func expandSpotCapacityRebalance(l []any) *awstypes.SpotCapacityRebalance {
 		capacityRebalance.ReplacementStrategy = awstypes.ReplacementStrategy(v.(string))
 	}
 
+	if v, ok := m["termination_delay"]; ok && v.(int) != 0 {
+		capacityRebalance.TerminationDelay = aws.Int32(int32(v.(int)))
+	}
+
 	return capacityRebalance
 }
 
@@ -2219,6 +2229,7 @@ func flattenSpotCapacityRebalance(spotCapacityRebalance *awstypes.SpotCapacityRe
 
 	m := map[string]any{
 		"replacement_strategy": spotCapacityRebalance.ReplacementStrategy,
+		"termination_delay":    aws.ToInt32(spotCapacityRebalance.TerminationDelay),
 	}
 
 	return []any{m}
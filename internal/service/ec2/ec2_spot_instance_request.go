@@ -73,6 +73,10 @@ func resourceSpotInstanceRequest() *schema.Resource {
 			delete(s, "instance_market_options")
 			delete(s, "spot_instance_request_id")
 
+			// Remove attributes that only apply to in-place instance updates.
+			delete(s, "allow_stop_for_update")
+			delete(s, "ena_support")
+
 			s["instance_interruption_behavior"] = &schema.Schema{
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2SpotPlacementScoresDataSource_instanceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_instanceTypes(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "spot_placement_scores.#", 1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.availability_zone_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.region"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotPlacementScoresDataSource_instanceRequirements(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_instanceRequirements(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "spot_placement_scores.#", 1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.region"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_instanceTypes() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_scores" "test" {
+  instance_types           = ["t3.micro", "t3.small"]
+  region_names             = [data.aws_region.current.region]
+  single_availability_zone = true
+  target_capacity          = 1
+}
+`
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_instanceRequirements() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_scores" "test" {
+  region_names              = [data.aws_region.current.region]
+  target_capacity           = 4
+  target_capacity_unit_type = "vcpu"
+
+  instance_requirements_with_metadata {
+    architecture_types = ["x86_64"]
+
+    instance_requirements {
+      memory_mib {
+        min = 1024
+      }
+
+      vcpu_count {
+        min = 2
+        max = 4
+      }
+    }
+  }
+}
+`
+}
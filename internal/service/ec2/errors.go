@@ -48,6 +48,7 @@ const (
 	errCodeInvalidGroupInUse                                       = "InvalidGroup.InUse"
 	errCodeInvalidGroupNotFound                                    = "InvalidGroup.NotFound"
 	errCodeInvalidHostIDNotFound                                   = "InvalidHostID.NotFound"
+	errCodeInvalidIPAMExternalResourceVerificationTokenIdNotFound  = "InvalidIpamExternalResourceVerificationTokenId.NotFound"
 	errCodeInvalidIPAMIdNotFound                                   = "InvalidIpamId.NotFound"
 	errCodeInvalidIPAMPoolAllocationIdNotFound                     = "InvalidIpamPoolAllocationId.NotFound"
 	errCodeInvalidIPAMPoolIdNotFound                               = "InvalidIpamPoolId.NotFound"
@@ -8,6 +8,7 @@ var (
 	ResourceAMICopy                                       = resourceAMICopy
 	ResourceAMIFromInstance                               = resourceAMIFromInstance
 	ResourceAMILaunchPermission                           = resourceAMILaunchPermission
+	ResourceAllowedImagesSettings                         = newAllowedImagesSettingsResource
 	ResourceAvailabilityZoneGroup                         = resourceAvailabilityZoneGroup
 	ResourceCapacityReservation                           = resourceCapacityReservation
 	ResourceCarrierGateway                                = resourceCarrierGateway
@@ -32,6 +33,7 @@ var (
 	ResourceFlowLog                                       = resourceFlowLog
 	ResourceHost                                          = resourceHost
 	ResourceIPAM                                          = resourceIPAM
+	ResourceIPAMExternalResourceVerificationToken         = newIPAMExternalResourceVerificationTokenResource
 	ResourceIPAMOrganizationAdminAccount                  = resourceIPAMOrganizationAdminAccount
 	ResourceIPAMPool                                      = resourceIPAMPool
 	ResourceIPAMPoolCIDR                                  = resourceIPAMPoolCIDR
@@ -72,6 +74,7 @@ var (
 	ResourceSecurityGroupEgressRule                       = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                      = newSecurityGroupIngressRuleResource
 	ResourceSecurityGroupRule                             = resourceSecurityGroupRule
+	ResourceSecurityGroupRules                            = newSecurityGroupRulesResource
 	ResourceSecurityGroupVPCAssociation                   = newSecurityGroupVPCAssociationResource
 	ResourceSnapshotCreateVolumePermission                = resourceSnapshotCreateVolumePermission
 	ResourceSpotDataFeedSubscription                      = resourceSpotDataFeedSubscription
@@ -100,6 +103,7 @@ var (
 	ResourceTransitGatewayRouteTable                      = resourceTransitGatewayRouteTable
 	ResourceTransitGatewayRouteTableAssociation           = resourceTransitGatewayRouteTableAssociation
 	ResourceTransitGatewayRouteTablePropagation           = resourceTransitGatewayRouteTablePropagation
+	ResourceTransitGatewayRouteTableStaticRoutes          = newTransitGatewayRouteTableStaticRoutesResource
 	ResourceTransitGatewayVPCAttachment                   = resourceTransitGatewayVPCAttachment
 	ResourceTransitGatewayVPCAttachmentAccepter           = resourceTransitGatewayVPCAttachmentAccepter
 	ResourceVPCBlockPublicAccessExclusion                 = newVPCBlockPublicAccessExclusionResource
@@ -140,6 +144,7 @@ var (
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone         = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                          = errCodeInvalidSpotDatafeedNotFound
 	ExpandIPPerms                                               = expandIPPerms
+	FindAllowedImagesSettings                                   = findAllowedImagesSettings
 	FindAvailabilityZones                                       = findAvailabilityZones
 	FindCapacityReservationByID                                 = findCapacityReservationByID
 	FindCarrierGatewayByID                                      = findCarrierGatewayByID
@@ -160,6 +165,7 @@ var (
 	FindFastSnapshotRestoreByTwoPartKey                         = findFastSnapshotRestoreByTwoPartKey
 	FindFleetByID                                               = findFleetByID
 	FindFlowLogByID                                             = findFlowLogByID
+	FindFlowLogsByIDs                                           = findFlowLogsByIDs
 	FindHostByID                                                = findHostByID
 	FindIPAMByID                                                = findIPAMByID
 	FindIPAMPoolAllocationByTwoPartKey                          = findIPAMPoolAllocationByTwoPartKey
@@ -199,6 +205,7 @@ var (
 	FindRouteByIPv4Destination                                  = findRouteByIPv4Destination
 	FindRouteByIPv6Destination                                  = findRouteByIPv6Destination
 	FindRouteByPrefixListIDDestination                          = findRouteByPrefixListIDDestination
+	FindIPAMExternalResourceVerificationTokenByID               = findIPAMExternalResourceVerificationTokenByID
 	FindRouteServerByID                                         = findRouteServerByID
 	FindRouteServerAssociationByTwoPartKey                      = findRouteServerAssociationByTwoPartKey
 	FindRouteServerEndpointByID                                 = findRouteServerEndpointByID
@@ -209,6 +216,7 @@ var (
 	FindSecurityGroupByID                                       = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                             = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                            = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRulesBySecurityGroupID                     = findSecurityGroupRulesBySecurityGroupID
 	FindSecurityGroupVPCAssociationByTwoPartKey                 = findSecurityGroupVPCAssociationByTwoPartKey
 	FindSnapshot                                                = findSnapshot
 	FindSnapshotByID                                            = findSnapshotByID
@@ -238,6 +246,7 @@ var (
 	FindTransitGatewayRouteTableByID                            = findTransitGatewayRouteTableByID
 	FindTransitGatewayRouteTablePropagationByTwoPartKey         = findTransitGatewayRouteTablePropagationByTwoPartKey
 	FindTransitGatewayStaticRoute                               = findTransitGatewayStaticRoute
+	FindTransitGatewayStaticRoutesByRouteTableID                = findTransitGatewayStaticRoutesByRouteTableID
 	FindTransitGatewayVPCAttachmentByID                         = findTransitGatewayVPCAttachmentByID
 	FindVPCBlockPublicAccessExclusionByID                       = findVPCBlockPublicAccessExclusionByID
 	FindVPCCIDRBlockAssociationByID                             = findVPCCIDRBlockAssociationByID
@@ -290,6 +299,7 @@ var (
 	SecurityGroupRuleCreateID                                   = securityGroupRuleCreateID
 	SecurityGroupRuleHash                                       = securityGroupRuleHash
 	SecurityGroupRuleMigrateState                               = securityGroupRuleMigrateState
+	SplitCIDRBlock                                              = splitCIDRBlock
 	SpotFleetRequestMigrateState                                = spotFleetRequestMigrateState
 	StopEBSVolumeAttachmentInstance                             = stopVolumeAttachmentInstance
 	StopInstance                                                = stopInstance
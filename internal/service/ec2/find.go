@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"net/netip"
 	"slices"
 	"strconv"
 	"strings"
@@ -290,6 +291,33 @@ func findFleets(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFleets
 	return output, nil
 }
 
+func findFleetInstances(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFleetInstancesInput) ([]awstypes.ActiveInstance, error) {
+	var output []awstypes.ActiveInstance
+
+	err := describeFleetInstancesPages(ctx, conn, input, func(page *ec2.DescribeFleetInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.ActiveInstances...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidFleetIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func findFleetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.FleetData, error) {
 	input := ec2.DescribeFleetsInput{
 		FleetIds: []string{id},
@@ -528,6 +556,28 @@ func findInstanceCreditSpecificationByID(ctx context.Context, conn *ec2.Client,
 	return output, nil
 }
 
+func findAllowedImagesSettings(ctx context.Context, conn *ec2.Client) (*ec2.GetAllowedImagesSettingsOutput, error) {
+	input := ec2.GetAllowedImagesSettingsInput{}
+	output, err := conn.GetAllowedImagesSettings(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.ToString(output.State); state == "" || state == string(awstypes.AllowedImagesSettingsDisabledStateDisabled) {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findInstanceMetadataDefaults(ctx context.Context, conn *ec2.Client) (*awstypes.InstanceMetadataDefaultsResponse, error) {
 	input := ec2.GetInstanceMetadataDefaultsInput{}
 	output, err := conn.GetInstanceMetadataDefaults(ctx, &input)
@@ -4354,6 +4404,71 @@ func findIPAMResourceDiscoveryByID(ctx context.Context, conn *ec2.Client, id str
 	return output, nil
 }
 
+func findIPAMExternalResourceVerificationToken(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamExternalResourceVerificationTokensInput) (*awstypes.IpamExternalResourceVerificationToken, error) {
+	output, err := findIPAMExternalResourceVerificationTokens(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIPAMExternalResourceVerificationTokens(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamExternalResourceVerificationTokensInput) ([]awstypes.IpamExternalResourceVerificationToken, error) {
+	var output []awstypes.IpamExternalResourceVerificationToken
+
+	err := describeIpamExternalResourceVerificationTokensPages(ctx, conn, input, func(page *ec2.DescribeIpamExternalResourceVerificationTokensOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.IpamExternalResourceVerificationTokens...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMExternalResourceVerificationTokenIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findIPAMExternalResourceVerificationTokenByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.IpamExternalResourceVerificationToken, error) {
+	input := ec2.DescribeIpamExternalResourceVerificationTokensInput{
+		IpamExternalResourceVerificationTokenIds: []string{id},
+	}
+
+	output, err := findIPAMExternalResourceVerificationToken(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.IpamExternalResourceVerificationTokenStateDeleteComplete {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.IpamExternalResourceVerificationTokenId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
 func findIPAMResourceDiscoveryAssociation(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamResourceDiscoveryAssociationsInput) (*awstypes.IpamResourceDiscoveryAssociation, error) {
 	output, err := findIPAMResourceDiscoveryAssociations(ctx, conn, input)
 
@@ -5268,6 +5383,107 @@ func findTransitGatewayStaticRoute(ctx context.Context, conn *ec2.Client, transi
 	return nil, &retry.NotFoundError{}
 }
 
+func findTransitGatewayStaticRoutesByRouteTableID(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string) ([]awstypes.TransitGatewayRoute, error) {
+	filters := []awstypes.Filter{
+		newFilter(names.AttrType, enum.Slice(awstypes.TransitGatewayRouteTypeStatic)),
+		newFilter(names.AttrState, enum.Slice(awstypes.TransitGatewayRouteStateActive, awstypes.TransitGatewayRouteStateBlackhole, awstypes.TransitGatewayRouteStatePending)),
+	}
+
+	var output []awstypes.TransitGatewayRoute
+	seen := make(map[string]struct{})
+
+	// SearchTransitGatewayRoutes does not support pagination, so the IPv4 and IPv6 address spaces are searched
+	// separately and split into smaller CIDR blocks until each search returns all of its routes.
+	for _, v := range []string{"0.0.0.0/0", "::/0"} {
+		prefix := netip.MustParsePrefix(v)
+
+		// The default route is only guaranteed to be returned by an exact match.
+		exact, err := searchTransitGatewayRoutesByCIDRBlock(ctx, conn, transitGatewayRouteTableID, filters, "route-search.exact-match", prefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		subnets, err := findTransitGatewayRoutesInCIDRBlock(ctx, conn, transitGatewayRouteTableID, filters, prefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, route := range slices.Concat(exact.Routes, subnets) {
+			destination := types.CanonicalCIDRBlock(aws.ToString(route.DestinationCidrBlock))
+			if _, ok := seen[destination]; ok {
+				continue
+			}
+			seen[destination] = struct{}{}
+			output = append(output, route)
+		}
+	}
+
+	return output, nil
+}
+
+// findTransitGatewayRoutesInCIDRBlock returns the routes matching the specified filters whose destination is within the specified CIDR block.
+// If the search exceeds the SearchTransitGatewayRoutes result limit, the CIDR block itself and each of its two halves are searched instead.
+func findTransitGatewayRoutesInCIDRBlock(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, filters []awstypes.Filter, prefix netip.Prefix) ([]awstypes.TransitGatewayRoute, error) {
+	output, err := searchTransitGatewayRoutesByCIDRBlock(ctx, conn, transitGatewayRouteTableID, filters, "route-search.subnet-of-match", prefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lo, hi, ok := splitCIDRBlock(prefix)
+	if !aws.ToBool(output.AdditionalRoutesAvailable) || !ok {
+		return output.Routes, nil
+	}
+
+	exact, err := searchTransitGatewayRoutesByCIDRBlock(ctx, conn, transitGatewayRouteTableID, filters, "route-search.exact-match", prefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	routes := exact.Routes
+	for _, v := range []netip.Prefix{lo, hi} {
+		output, err := findTransitGatewayRoutesInCIDRBlock(ctx, conn, transitGatewayRouteTableID, filters, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		routes = append(routes, output...)
+	}
+
+	return routes, nil
+}
+
+func searchTransitGatewayRoutesByCIDRBlock(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, filters []awstypes.Filter, routeSearchFilter string, prefix netip.Prefix) (*ec2.SearchTransitGatewayRoutesOutput, error) {
+	input := ec2.SearchTransitGatewayRoutesInput{
+		Filters:                    append(slices.Clone(filters), newFilter(routeSearchFilter, []string{prefix.String()})),
+		MaxResults:                 aws.Int32(transitGatewayRoutesSearchMaxResults),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	output, err := conn.SearchTransitGatewayRoutes(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findTransitGatewayRoutes(ctx context.Context, conn *ec2.Client, input *ec2.SearchTransitGatewayRoutesInput) ([]awstypes.TransitGatewayRoute, error) {
 	output, err := conn.SearchTransitGatewayRoutes(ctx, input)
 
@@ -6040,6 +6256,30 @@ func findFlowLogByID(ctx context.Context, conn *ec2.Client, id string) (*awstype
 	return output, nil
 }
 
+func findFlowLogsByIDs(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.FlowLog, error) {
+	if len(ids) == 0 {
+		return nil, &retry.NotFoundError{}
+	}
+
+	input := ec2.DescribeFlowLogsInput{
+		FlowLogIds: ids,
+	}
+
+	output, err := findFlowLogs(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
 func findFlowLogs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFlowLogsInput) ([]awstypes.FlowLog, error) {
 	var output []awstypes.FlowLog
 
@@ -6886,18 +7126,28 @@ func findCapacityBlockOfferings(ctx context.Context, conn *ec2.Client, input *ec
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAnalysisIdNotFound) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: &input,
-			}
+		if err != nil {
+			return nil, err
 		}
 
+		output = append(output, page.CapacityBlockOfferings...)
+	}
+
+	return output, nil
+}
+
+func findSpotPlacementScores(ctx context.Context, conn *ec2.Client, input *ec2.GetSpotPlacementScoresInput) ([]awstypes.SpotPlacementScore, error) {
+	var output []awstypes.SpotPlacementScore
+
+	pages := ec2.NewGetSpotPlacementScoresPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
 		if err != nil {
 			return nil, err
 		}
 
-		output = append(output, page.CapacityBlockOfferings...)
+		output = append(output, page.SpotPlacementScores...)
 	}
 
 	return output, nil
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcBlockPublicAccessExclusions,DescribeVpcEndpointAssociations,DescribeVpcEndpointServices"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeFleetInstances,DescribeIpamExternalResourceVerificationTokens,DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcBlockPublicAccessExclusions,DescribeVpcEndpointAssociations,DescribeVpcEndpointServices"; DO NOT EDIT.
 
 package ec2
 
@@ -9,6 +9,38 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
+func describeFleetInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFleetInstancesInput, fn func(*ec2.DescribeFleetInstancesOutput, bool) bool, optFns ...func(*ec2.Options)) error {
+	for {
+		output, err := conn.DescribeFleetInstances(ctx, input, optFns...)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeIpamExternalResourceVerificationTokensPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamExternalResourceVerificationTokensInput, fn func(*ec2.DescribeIpamExternalResourceVerificationTokensOutput, bool) bool, optFns ...func(*ec2.Options)) error {
+	for {
+		output, err := conn.DescribeIpamExternalResourceVerificationTokens(ctx, input, optFns...)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeSpotFleetInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSpotFleetInstancesInput, fn func(*ec2.DescribeSpotFleetInstancesOutput, bool) bool, optFns ...func(*ec2.Options)) error {
 	for {
 		output, err := conn.DescribeSpotFleetInstances(ctx, input, optFns...)
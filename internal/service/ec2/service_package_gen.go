@@ -38,6 +38,24 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Capacity Block Offering",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newCapacityBlockOfferingsDataSource,
+			TypeName: "aws_ec2_capacity_block_offerings",
+			Name:     "Capacity Block Offerings",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newInstanceConnectEndpointDataSource,
+			TypeName: "aws_ec2_instance_connect_endpoint",
+			Name:     "Instance Connect Endpoint",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newSpotPlacementScoresDataSource,
+			TypeName: "aws_ec2_spot_placement_scores",
+			Name:     "Spot Placement Scores",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSpotDataFeedSubscriptionDataSource,
 			TypeName: "aws_spot_datafeed_subscription",
@@ -93,6 +111,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "EBS Fast Snapshot Restore",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newAllowedImagesSettingsResource,
+			TypeName: "aws_ec2_allowed_images_settings",
+			Name:     "Allowed Images Settings",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newCapacityBlockReservationResource,
 			TypeName: "aws_ec2_capacity_block_reservation",
@@ -135,12 +159,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Transit Gateway Default Route Table Propagation",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newTransitGatewayRouteTableStaticRoutesResource,
+			TypeName: "aws_ec2_transit_gateway_route_table_static_routes",
+			Name:     "Transit Gateway Route Table Static Routes",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newEIPDomainNameResource,
 			TypeName: "aws_eip_domain_name",
 			Name:     "EIP Domain Name",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newFlowLogsResource,
+			TypeName: "aws_flow_logs",
+			Name:     "Flow Logs",
+			Tags:     unique.Make(inttypes.ServicePackageResourceTags{}),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newNATGatewayEIPAssociationResource,
 			TypeName: "aws_nat_gateway_eip_association",
@@ -180,6 +217,15 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "VPC Endpoint Service Private DNS Verification",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newIPAMExternalResourceVerificationTokenResource,
+			TypeName: "aws_vpc_ipam_external_resource_verification_token",
+			Name:     "IPAM External Resource Verification Token",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newVPCRouteServerResource,
 			TypeName: "aws_vpc_route_server",
@@ -245,6 +291,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newSecurityGroupRulesResource,
+			TypeName: "aws_vpc_security_group_rules",
+			Name:     "Security Group Rules",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSecurityGroupVPCAssociationResource,
 			TypeName: "aws_vpc_security_group_vpc_association",
@@ -354,6 +406,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "COIP Pools",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceFleetInstances,
+			TypeName: "aws_ec2_fleet_instances",
+			Name:     "Fleet Instances",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourceHost,
 			TypeName: "aws_ec2_host",
@@ -555,6 +613,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Transit Gateway Route Table Associations",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceTransitGatewayRouteTablePropagatedRoutes,
+			TypeName: "aws_ec2_transit_gateway_route_table_propagated_routes",
+			Name:     "Transit Gateway Route Table Propagated Routes",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourceTransitGatewayRouteTablePropagations,
 			TypeName: "aws_ec2_transit_gateway_route_table_propagations",
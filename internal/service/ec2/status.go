@@ -1715,6 +1715,22 @@ func statusVPCBlockPublicAccessExclusion(ctx context.Context, conn *ec2.Client,
 	}
 }
 
+func statusIPAMExternalResourceVerificationToken(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findIPAMExternalResourceVerificationTokenByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
 func statusRouteServer(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findRouteServerByID(ctx, conn, id)
@@ -74,6 +74,9 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 			"Filter":        testAccTransitGatewayRouteTablePropagationsDataSource_filter,
 			acctest.CtBasic: testAccTransitGatewayRouteTablePropagationsDataSource_basic,
 		},
+		"RouteTablePropagatedRoutes": {
+			acctest.CtBasic: testAccTransitGatewayRouteTablePropagatedRoutesDataSource_basic,
+		},
 		"RouteTableRoutes": {
 			acctest.CtBasic: testAccTransitGatewayRouteTableRoutesDataSource_basic,
 		},
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_transit_gateway_route_table_propagated_routes", name="Transit Gateway Route Table Propagated Routes")
+func dataSourceTransitGatewayRouteTablePropagatedRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransitGatewayRouteTablePropagatedRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix_list_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_attachments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrResourceID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrResourceType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrTransitGatewayAttachmentID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayRouteTablePropagatedRoutesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	tgwRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters: []awstypes.Filter{
+			newFilter(names.AttrType, enum.Slice(awstypes.TransitGatewayRouteTypePropagated)),
+		},
+		MaxResults:                 aws.Int32(transitGatewayRoutesSearchMaxResults),
+		TransitGatewayRouteTableId: aws.String(tgwRouteTableID),
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterList(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	output, err := findTransitGatewayRoutes(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table (%s) Propagated Routes: %s", tgwRouteTableID, err)
+	}
+
+	d.SetId(tgwRouteTableID)
+
+	routes := []any{}
+	for _, route := range output {
+		attachments := []any{}
+		for _, attachment := range route.TransitGatewayAttachments {
+			attachments = append(attachments, map[string]any{
+				names.AttrResourceID:                 aws.ToString(attachment.ResourceId),
+				names.AttrResourceType:               attachment.ResourceType,
+				names.AttrTransitGatewayAttachmentID: aws.ToString(attachment.TransitGatewayAttachmentId),
+			})
+		}
+
+		routes = append(routes, map[string]any{
+			"destination_cidr_block":      aws.ToString(route.DestinationCidrBlock),
+			"prefix_list_id":              aws.ToString(route.PrefixListId),
+			names.AttrState:               route.State,
+			"transit_gateway_attachments": attachments,
+		})
+	}
+
+	if err := d.Set("routes", routes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting routes: %s", err)
+	}
+
+	return diags
+}
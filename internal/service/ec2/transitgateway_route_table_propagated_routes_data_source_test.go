@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayRouteTablePropagatedRoutesDataSource_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table_propagated_routes.test"
+	transitGatewayVpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablePropagatedRoutesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.destination_cidr_block", vpcResourceName, names.AttrCIDRBlock),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.transit_gateway_attachments.0.resource_id", vpcResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.0.resource_type", "vpc"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.transit_gateway_attachments.0.transit_gateway_attachment_id", transitGatewayVpcAttachmentResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTablePropagatedRoutesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableStaticRoutesConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_propagation" "test" {
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+
+resource "aws_ec2_transit_gateway_route" "test" {
+  destination_cidr_block         = "10.1.0.0/16"
+  blackhole                      = true
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+
+data "aws_ec2_transit_gateway_route_table_propagated_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  depends_on = [
+    aws_ec2_transit_gateway_route.test,
+    aws_ec2_transit_gateway_route_table_propagation.test,
+  ]
+}
+`)
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_transit_gateway_route_table_static_routes", name="Transit Gateway Route Table Static Routes")
+func newTransitGatewayRouteTableStaticRoutesResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &transitGatewayRouteTableStaticRoutesResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// Number of route changes issued before waiting for the route table to settle.
+	transitGatewayStaticRoutesBatchSize = 100
+	// Maximum number of routes returned by a single SearchTransitGatewayRoutes call.
+	transitGatewayRoutesSearchMaxResults = 1000
+)
+
+// splitCIDRBlock returns the two halves of the specified CIDR block.
+// ok is false if the CIDR block is a single address.
+func splitCIDRBlock(prefix netip.Prefix) (lo, hi netip.Prefix, ok bool) {
+	prefix = prefix.Masked()
+	bits := prefix.Bits()
+
+	if bits < 0 || bits >= prefix.Addr().BitLen() {
+		return lo, hi, false
+	}
+
+	b := prefix.Addr().AsSlice()
+	b[bits/8] |= 0x80 >> (bits % 8)
+	addr, _ := netip.AddrFromSlice(b)
+
+	return netip.PrefixFrom(prefix.Addr(), bits+1), netip.PrefixFrom(addr, bits+1), true
+}
+
+type transitGatewayRouteTableStaticRoutesResource struct {
+	framework.ResourceWithModel[transitGatewayRouteTableStaticRoutesResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *transitGatewayRouteTableStaticRoutesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"transit_gateway_route_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"route": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[transitGatewayStaticRouteModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"blackhole": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"destination_cidr_block": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.Any(
+									fwvalidators.IPv4CIDRNetworkAddress(),
+									fwvalidators.IPv6CIDRNetworkAddress(),
+								),
+							},
+						},
+						names.AttrTransitGatewayAttachmentID: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *transitGatewayRouteTableStaticRoutesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data transitGatewayRouteTableStaticRoutesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := fwflex.StringValueFromFramework(ctx, data.TransitGatewayRouteTableID)
+	routes, diags := data.Routes.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := reconcileTransitGatewayStaticRoutes(ctx, conn, routeTableID, routes, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Transit Gateway Route Table (%s) Static Routes", routeTableID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.TransitGatewayRouteTableID
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *transitGatewayRouteTableStaticRoutesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data transitGatewayRouteTableStaticRoutesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findTransitGatewayStaticRoutesByRouteTableID(ctx, conn, routeTableID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Transit Gateway Route Table (%s) Static Routes", routeTableID), err.Error())
+
+		return
+	}
+
+	prior, diags := data.Routes.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Preserve the configured representation of each destination.
+	destinations := make(map[string]types.String, len(prior))
+	for _, v := range prior {
+		destinations[inttypes.CanonicalCIDRBlock(v.DestinationCIDRBlock.ValueString())] = v.DestinationCIDRBlock
+	}
+
+	var routes []*transitGatewayStaticRouteModel
+	for _, apiObject := range output {
+		route := flattenTransitGatewayStaticRoute(ctx, &apiObject)
+		if v, ok := destinations[inttypes.CanonicalCIDRBlock(route.DestinationCIDRBlock.ValueString())]; ok {
+			route.DestinationCIDRBlock = v
+		}
+		routes = append(routes, route)
+	}
+
+	if len(routes) > 0 || !data.Routes.IsNull() {
+		data.Routes = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, routes)
+	}
+	data.TransitGatewayRouteTableID = data.ID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transitGatewayRouteTableStaticRoutesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new transitGatewayRouteTableStaticRoutesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := fwflex.StringValueFromFramework(ctx, new.ID)
+	routes, diags := new.Routes.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := reconcileTransitGatewayStaticRoutes(ctx, conn, routeTableID, routes, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Transit Gateway Route Table (%s) Static Routes", routeTableID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *transitGatewayRouteTableStaticRoutesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data transitGatewayRouteTableStaticRoutesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := fwflex.StringValueFromFramework(ctx, data.ID)
+	err := reconcileTransitGatewayStaticRoutes(ctx, conn, routeTableID, nil, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Transit Gateway Route Table (%s) Static Routes", routeTableID), err.Error())
+
+		return
+	}
+}
+
+// reconcileTransitGatewayStaticRoutes makes the route table's static routes match the specified routes.
+// The route table is read once and changes are issued in batches, waiting for the route table to settle after each batch.
+func reconcileTransitGatewayStaticRoutes(ctx context.Context, conn *ec2.Client, routeTableID string, routes []*transitGatewayStaticRouteModel, timeout time.Duration) error {
+	output, err := findTransitGatewayStaticRoutesByRouteTableID(ctx, conn, routeTableID)
+
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]*transitGatewayStaticRouteModel, len(output))
+	for _, apiObject := range output {
+		route := flattenTransitGatewayStaticRoute(ctx, &apiObject)
+		existing[route.DestinationCIDRBlock.ValueString()] = route
+	}
+
+	var creates, replaces []*transitGatewayStaticRouteModel
+	for _, route := range routes {
+		destination := inttypes.CanonicalCIDRBlock(route.DestinationCIDRBlock.ValueString())
+		v, ok := existing[destination]
+		if !ok {
+			creates = append(creates, route)
+			continue
+		}
+
+		if !route.Blackhole.Equal(v.Blackhole) || route.TransitGatewayAttachmentID.ValueString() != v.TransitGatewayAttachmentID.ValueString() {
+			replaces = append(replaces, route)
+		}
+		delete(existing, destination)
+	}
+
+	var deletes []string
+	for destination := range existing {
+		deletes = append(deletes, destination)
+	}
+	slices.Sort(deletes)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for chunk := range slices.Chunk(deletes, transitGatewayStaticRoutesBatchSize) {
+		for _, destination := range chunk {
+			input := ec2.DeleteTransitGatewayRouteInput{
+				DestinationCidrBlock:       aws.String(destination),
+				TransitGatewayRouteTableId: aws.String(routeTableID),
+			}
+			_, err := conn.DeleteTransitGatewayRoute(ctx, &input)
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting route (%s): %w", destination, err)
+			}
+		}
+
+		if err := waitTransitGatewayStaticRoutesSettled(ctx, conn, routeTableID, timeout); err != nil {
+			return fmt.Errorf("waiting for routes delete: %w", err)
+		}
+	}
+
+	for chunk := range slices.Chunk(replaces, transitGatewayStaticRoutesBatchSize) {
+		for _, route := range chunk {
+			input := ec2.ReplaceTransitGatewayRouteInput{
+				Blackhole:                  fwflex.BoolFromFramework(ctx, route.Blackhole),
+				DestinationCidrBlock:       fwflex.StringFromFramework(ctx, route.DestinationCIDRBlock),
+				TransitGatewayAttachmentId: fwflex.StringFromFramework(ctx, route.TransitGatewayAttachmentID),
+				TransitGatewayRouteTableId: aws.String(routeTableID),
+			}
+
+			if _, err := conn.ReplaceTransitGatewayRoute(ctx, &input); err != nil {
+				return fmt.Errorf("replacing route (%s): %w", route.DestinationCIDRBlock.ValueString(), err)
+			}
+		}
+
+		if err := waitTransitGatewayStaticRoutesSettled(ctx, conn, routeTableID, timeout); err != nil {
+			return fmt.Errorf("waiting for routes update: %w", err)
+		}
+	}
+
+	for chunk := range slices.Chunk(creates, transitGatewayStaticRoutesBatchSize) {
+		for _, route := range chunk {
+			input := ec2.CreateTransitGatewayRouteInput{
+				Blackhole:                  fwflex.BoolFromFramework(ctx, route.Blackhole),
+				DestinationCidrBlock:       fwflex.StringFromFramework(ctx, route.DestinationCIDRBlock),
+				TransitGatewayAttachmentId: fwflex.StringFromFramework(ctx, route.TransitGatewayAttachmentID),
+				TransitGatewayRouteTableId: aws.String(routeTableID),
+			}
+
+			if _, err := conn.CreateTransitGatewayRoute(ctx, &input); err != nil {
+				return fmt.Errorf("creating route (%s): %w", route.DestinationCIDRBlock.ValueString(), err)
+			}
+		}
+
+		if err := waitTransitGatewayStaticRoutesSettled(ctx, conn, routeTableID, timeout); err != nil {
+			return fmt.Errorf("waiting for routes create: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenTransitGatewayStaticRoute(ctx context.Context, apiObject *awstypes.TransitGatewayRoute) *transitGatewayStaticRouteModel {
+	route := &transitGatewayStaticRouteModel{
+		Blackhole:                  types.BoolValue(true),
+		DestinationCIDRBlock:       types.StringValue(inttypes.CanonicalCIDRBlock(aws.ToString(apiObject.DestinationCidrBlock))),
+		TransitGatewayAttachmentID: types.StringNull(),
+	}
+
+	if len(apiObject.TransitGatewayAttachments) > 0 {
+		route.Blackhole = types.BoolValue(false)
+		route.TransitGatewayAttachmentID = fwflex.StringToFramework(ctx, apiObject.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+	}
+
+	return route
+}
+
+type transitGatewayRouteTableStaticRoutesResourceModel struct {
+	framework.WithRegionModel
+	ID                         types.String                                                   `tfsdk:"id"`
+	Routes                     fwtypes.SetNestedObjectValueOf[transitGatewayStaticRouteModel] `tfsdk:"route"`
+	Timeouts                   timeouts.Value                                                 `tfsdk:"timeouts"`
+	TransitGatewayRouteTableID types.String                                                   `tfsdk:"transit_gateway_route_table_id"`
+}
+
+type transitGatewayStaticRouteModel struct {
+	Blackhole                  types.Bool   `tfsdk:"blackhole"`
+	DestinationCIDRBlock       types.String `tfsdk:"destination_cidr_block"`
+	TransitGatewayAttachmentID types.String `tfsdk:"transit_gateway_attachment_id"`
+}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSplitCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prefix     string
+		expectedLo string
+		expectedHi string
+		expectedOK bool
+	}{
+		"IPv4 default": {
+			prefix:     "0.0.0.0/0",
+			expectedLo: "0.0.0.0/1",
+			expectedHi: "128.0.0.0/1",
+			expectedOK: true,
+		},
+		"IPv4": {
+			prefix:     "10.0.0.0/8",
+			expectedLo: "10.0.0.0/9",
+			expectedHi: "10.128.0.0/9",
+			expectedOK: true,
+		},
+		"IPv4 unmasked": {
+			prefix:     "10.1.2.3/23",
+			expectedLo: "10.1.2.0/24",
+			expectedHi: "10.1.3.0/24",
+			expectedOK: true,
+		},
+		"IPv4 host": {
+			prefix: "10.1.2.3/32",
+		},
+		"IPv6 default": {
+			prefix:     "::/0",
+			expectedLo: "::/1",
+			expectedHi: "8000::/1",
+			expectedOK: true,
+		},
+		"IPv6": {
+			prefix:     "2001:db8::/32",
+			expectedLo: "2001:db8::/33",
+			expectedHi: "2001:db8:8000::/33",
+			expectedOK: true,
+		},
+		"IPv6 host": {
+			prefix: "2001:db8::1/128",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lo, hi, ok := tfec2.SplitCIDRBlock(netip.MustParsePrefix(testCase.prefix))
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Fatalf("ok: got %t, want %t", got, want)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got, want := lo.String(), testCase.expectedLo; got != want {
+				t.Errorf("lo: got %s, want %s", got, want)
+			}
+
+			if got, want := hi.String(), testCase.expectedHi; got != want {
+				t.Errorf("hi: got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func testAccTransitGatewayRouteTableStaticRoutes_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v []awstypes.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_route_table_static_routes.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	transitGatewayVpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableStaticRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableStaticRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableStaticRoutesExists(ctx, resourceName, &v),
+					testAccCheckTransitGatewayRouteTableStaticRoutesCount(&v, 2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, transitGatewayRouteTableResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              acctest.CtFalse,
+						"destination_cidr_block": "10.1.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.transit_gateway_attachment_id", transitGatewayVpcAttachmentResourceName, names.AttrID),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              acctest.CtTrue,
+						"destination_cidr_block": "10.2.0.0/16",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableStaticRoutes_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v []awstypes.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_route_table_static_routes.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableStaticRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableStaticRoutesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableStaticRoutesExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGatewayRouteTableStaticRoutes, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableStaticRoutes_update(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v []awstypes.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_route_table_static_routes.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableStaticRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableStaticRoutesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableStaticRoutesExists(ctx, resourceName, &v),
+					testAccCheckTransitGatewayRouteTableStaticRoutesCount(&v, 2),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableStaticRoutesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableStaticRoutesExists(ctx, resourceName, &v),
+					testAccCheckTransitGatewayRouteTableStaticRoutesCount(&v, 2),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              acctest.CtTrue,
+						"destination_cidr_block": "10.1.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              acctest.CtFalse,
+						"destination_cidr_block": "10.3.0.0/16",
+					}),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableStaticRoutesConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableStaticRoutesExists(ctx, resourceName, &v),
+					testAccCheckTransitGatewayRouteTableStaticRoutesCount(&v, 0),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRouteTableStaticRoutesCount(v *[]awstypes.TransitGatewayRoute, n int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(*v); got != n {
+			return fmt.Errorf("expected %d EC2 Transit Gateway static routes, got %d", n, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteTableStaticRoutesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_route_table_static_routes" {
+				continue
+			}
+
+			output, err := tfec2.FindTransitGatewayStaticRoutesByRouteTableID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("EC2 Transit Gateway Route Table %s static routes still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteTableStaticRoutesExists(ctx context.Context, n string, v *[]awstypes.TransitGatewayRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindTransitGatewayStaticRoutesByRouteTableID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayRouteTableStaticRoutesConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = aws_subnet.test[*].id
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayRouteTableStaticRoutesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableStaticRoutesConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_static_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  route {
+    destination_cidr_block        = "10.1.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    blackhole              = true
+    destination_cidr_block = "10.2.0.0/16"
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableStaticRoutesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableStaticRoutesConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_static_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  route {
+    blackhole              = true
+    destination_cidr_block = "10.1.0.0/16"
+  }
+
+  route {
+    destination_cidr_block        = "10.3.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableStaticRoutesConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableStaticRoutesConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_static_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`)
+}
@@ -154,6 +154,11 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"attachmentChange":   testAccTransitGatewayRouteTablePropagation_attachmentChange,
 			"recreatedDXGateway": testAccTransitGatewayRouteTablePropagtion_recreatedDXGateway,
 		},
+		"RouteTableStaticRoutes": {
+			acctest.CtBasic:      testAccTransitGatewayRouteTableStaticRoutes_basic,
+			acctest.CtDisappears: testAccTransitGatewayRouteTableStaticRoutes_disappears,
+			"update":             testAccTransitGatewayRouteTableStaticRoutes_update,
+		},
 		"VPCAttachment": {
 			acctest.CtBasic:                   testAccTransitGatewayVPCAttachment_basic,
 			acctest.CtDisappears:              testAccTransitGatewayVPCAttachment_disappears,
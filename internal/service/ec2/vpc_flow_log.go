@@ -5,10 +5,12 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -39,6 +41,8 @@ func resourceFlowLog() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceFlowLogCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -344,6 +348,56 @@ func resourceLogFlowDelete(ctx context.Context, d *schema.ResourceData, meta any
 	return diags
 }
 
+func resourceFlowLogCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	for _, key := range []string{"deliver_cross_account_role", names.AttrIAMRoleARN, "log_destination", "log_destination_type"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return validateFlowLogCrossAccountDelivery(
+		meta.(*conns.AWSClient).AccountID(ctx),
+		diff.Get("log_destination_type").(string),
+		diff.Get("log_destination").(string),
+		diff.Get(names.AttrIAMRoleARN).(string),
+		diff.Get("deliver_cross_account_role").(string),
+	)
+}
+
+// validateFlowLogCrossAccountDelivery verifies the permissions wiring required to deliver flow logs to a
+// Kinesis Data Firehose delivery stream in another account.
+// See https://docs.aws.amazon.com/vpc/latest/userguide/flow-logs-firehose.html#firehose-cross-account-delivery.
+func validateFlowLogCrossAccountDelivery(accountID, logDestinationType, logDestination, iamRoleARN, deliverCrossAccountRole string) error {
+	isFirehose := logDestinationType == string(awstypes.LogDestinationTypeKinesisDataFirehose)
+
+	if deliverCrossAccountRole != "" && !isFirehose {
+		return fmt.Errorf("deliver_cross_account_role must not be set when log_destination_type = %q", logDestinationType)
+	}
+
+	if !isFirehose || logDestination == "" {
+		return nil
+	}
+
+	destinationARN, err := arn.Parse(logDestination)
+	if err != nil {
+		return fmt.Errorf("parsing log_destination (%s): %w", logDestination, err)
+	}
+
+	if destinationARN.AccountID == "" || destinationARN.AccountID == accountID {
+		return nil
+	}
+
+	if iamRoleARN == "" {
+		return fmt.Errorf("iam_role_arn must be set when delivering flow logs to a Kinesis Data Firehose delivery stream in another account (%s)", destinationARN.AccountID)
+	}
+
+	if deliverCrossAccountRole == "" {
+		return fmt.Errorf("deliver_cross_account_role must be set when delivering flow logs to a Kinesis Data Firehose delivery stream in another account (%s)", destinationARN.AccountID)
+	}
+
+	return nil
+}
+
 func expandDestinationOptionsRequest(tfMap map[string]any) *awstypes.DestinationOptionsRequest {
 	if tfMap == nil {
 		return nil
@@ -263,6 +263,46 @@ func TestAccVPCFlowLog_LogDestinationType_kinesisFirehose(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_LogDestinationTypeKinesisFirehose_crossAccountInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_destinationTypeKinesisFirehoseCrossAccount(rName, false),
+				ExpectError: regexache.MustCompile(`iam_role_arn must be set when delivering flow logs to a Kinesis Data Firehose delivery stream in another account`),
+			},
+			{
+				Config:      testAccVPCFlowLogConfig_destinationTypeKinesisFirehoseCrossAccount(rName, true),
+				ExpectError: regexache.MustCompile(`deliver_cross_account_role must be set when delivering flow logs to a Kinesis Data Firehose delivery stream in another account`),
+			},
+		},
+	})
+}
+
+func TestAccVPCFlowLog_deliverCrossAccountRoleInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_deliverCrossAccountRoleS3(rName),
+				ExpectError: regexache.MustCompile(`deliver_cross_account_role must not be set when log_destination_type = "s3"`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_LogDestinationType_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog awstypes.FlowLog
@@ -1401,3 +1441,57 @@ EOF
 }
 `, rName))
 }
+
+func testAccVPCFlowLogConfig_destinationTypeKinesisFirehoseCrossAccount(rName string, iamRole bool) string {
+	var iamRoleARN string
+	if iamRole {
+		iamRoleARN = "iam_role_arn = aws_iam_role.test.arn"
+	}
+
+	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "delivery.logs.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_flow_log" "test" {
+  log_destination      = "arn:${data.aws_partition.current.partition}:firehose:${aws_vpc.test.region}:000000000000:deliverystream/%[1]s"
+  log_destination_type = "kinesis-data-firehose"
+  traffic_type         = "ALL"
+  vpc_id               = aws_vpc.test.id
+
+  %[2]s
+}
+`, rName, iamRoleARN))
+}
+
+func testAccVPCFlowLogConfig_deliverCrossAccountRoleS3(rName string) string {
+	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  deliver_cross_account_role = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+  log_destination            = aws_s3_bucket.test.arn
+  log_destination_type       = "s3"
+  traffic_type               = "ALL"
+  vpc_id                     = aws_vpc.test.id
+}
+`, rName))
+}
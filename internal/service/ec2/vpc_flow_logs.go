@@ -0,0 +1,518 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_flow_logs", name="Flow Logs")
+// @Tags
+// @Testing(tagsTest=false)
+func newFlowLogsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowLogsResource{}
+
+	return r, nil
+}
+
+const (
+	// CreateFlowLogs accepts a maximum of 25 transit gateway resource IDs per call.
+	// The same limit is applied to all resource types for simplicity.
+	flowLogsCreateBatchSize = 25
+)
+
+type flowLogsResource struct {
+	framework.ResourceWithModel[flowLogsResourceModel]
+}
+
+func (r *flowLogsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deliver_cross_account_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flow_log_ids": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"log_destination": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_destination_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogDestinationType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    fwtypes.StringEnumType[awstypes.LogDestinationType]().AttributeDefault(awstypes.LogDestinationTypeCloudWatchLogs),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_format": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_aggregation_interval": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int32default.StaticInt32(600),
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.OneOf(60, 600),
+				},
+			},
+			"resource_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowLogsResourceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"traffic_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TrafficType](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"destination_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowLogDestinationOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"file_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DestinationFileFormat](),
+							Optional:   true,
+							Computed:   true,
+							Default:    fwtypes.StringEnumType[awstypes.DestinationFileFormat]().AttributeDefault(awstypes.DestinationFileFormatPlainText),
+						},
+						"hive_compatible_partitions": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+						"per_hour_partition": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowLogsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowLogsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	resourceIDs := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceIDs)
+	flowLogIDs, err := createFlowLogs(ctx, conn, &data, resourceIDs, getTagSpecificationsIn(ctx, awstypes.ResourceTypeVpcFlowLog))
+
+	data.ID = types.StringValue(id.UniqueId())
+	response.Diagnostics.Append(fwflex.Flatten(ctx, flowLogIDs, &data.FlowLogIDs)...)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Flow Logs", err.Error())
+
+		// Persist any partially created flow logs so that they are cleaned up on the next apply.
+		if len(flowLogIDs) > 0 {
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+		}
+
+		return
+	}
+
+	flowLogs, err := findFlowLogsByIDs(ctx, conn, slices.Collect(maps.Values(flowLogIDs)))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Flow Logs (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flattenCommon(ctx, &flowLogs[0])...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowLogsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowLogsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	flowLogs, err := findFlowLogsByIDs(ctx, conn, slices.Collect(maps.Values(fwflex.ExpandFrameworkStringValueMap(ctx, data.FlowLogIDs))))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Flow Logs (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	flowLogIDs := make(map[string]string, len(flowLogs))
+	for _, v := range flowLogs {
+		flowLogIDs[aws.ToString(v.ResourceId)] = aws.ToString(v.FlowLogId)
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, flowLogIDs, &data.FlowLogIDs)...)
+	data.ResourceIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, slices.Collect(maps.Keys(flowLogIDs)))
+
+	response.Diagnostics.Append(data.flattenCommon(ctx, &flowLogs[0])...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, flowLogs[0].Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowLogsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowLogsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	flowLogIDs := fwflex.ExpandFrameworkStringValueMap(ctx, old.FlowLogIDs)
+	os, ns := fwflex.ExpandFrameworkStringValueSet(ctx, old.ResourceIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.ResourceIDs)
+
+	if del := os.Difference(ns); len(del) > 0 {
+		var ids []string
+		for _, v := range del {
+			if id, ok := flowLogIDs[v]; ok {
+				ids = append(ids, id)
+			}
+		}
+
+		if err := deleteFlowLogs(ctx, conn, ids); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Flow Logs (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		for _, v := range del {
+			delete(flowLogIDs, v)
+		}
+	}
+
+	if add := ns.Difference(os); len(add) > 0 {
+		created, err := createFlowLogs(ctx, conn, &new, add, getTagSpecificationsIn(ctx, awstypes.ResourceTypeVpcFlowLog))
+
+		maps.Copy(flowLogIDs, created)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Flow Logs (%s)", new.ID.ValueString()), err.Error())
+			response.Diagnostics.Append(fwflex.Flatten(ctx, flowLogIDs, &new.FlowLogIDs)...)
+			response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+
+			return
+		}
+	}
+
+	// Flow logs created above were tagged by CreateFlowLogs.
+	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
+		for _, resourceID := range ns {
+			if !slices.Contains(os, resourceID) {
+				continue
+			}
+
+			id := flowLogIDs[resourceID]
+			if err := updateTags(ctx, conn, id, oldTagsAll, newTagsAll); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Flow Log (%s) tags", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, flowLogIDs, &new.FlowLogIDs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowLogsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowLogsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	ids := slices.Collect(maps.Values(fwflex.ExpandFrameworkStringValueMap(ctx, data.FlowLogIDs)))
+	if err := deleteFlowLogs(ctx, conn, ids); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Flow Logs (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowLogsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data flowLogsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range []interface{ IsUnknown() bool }{data.DeliverCrossAccountRole, data.IAMRoleARN, data.LogDestination, data.LogDestinationType} {
+		if v.IsUnknown() {
+			return
+		}
+	}
+
+	if err := validateFlowLogCrossAccountDelivery(
+		r.Meta().AccountID(ctx),
+		data.LogDestinationType.ValueString(),
+		data.LogDestination.ValueString(),
+		data.IAMRoleARN.ValueString(),
+		data.DeliverCrossAccountRole.ValueString(),
+	); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("log_destination"), "Invalid Flow Logs Cross-Account Delivery Configuration", err.Error())
+	}
+}
+
+func createFlowLogs(ctx context.Context, conn *ec2.Client, data *flowLogsResourceModel, resourceIDs []string, tagSpecifications []awstypes.TagSpecification) (map[string]string, error) {
+	flowLogIDs := make(map[string]string)
+
+	for chunk := range slices.Chunk(resourceIDs, flowLogsCreateBatchSize) {
+		input := ec2.CreateFlowLogsInput{
+			ClientToken:              aws.String(id.UniqueId()),
+			DeliverCrossAccountRole:  fwflex.StringFromFramework(ctx, data.DeliverCrossAccountRole),
+			DeliverLogsPermissionArn: fwflex.StringFromFramework(ctx, data.IAMRoleARN),
+			LogDestinationType:       data.LogDestinationType.ValueEnum(),
+			LogFormat:                fwflex.StringFromFramework(ctx, data.LogFormat),
+			MaxAggregationInterval:   fwflex.Int32FromFramework(ctx, data.MaxAggregationInterval),
+			ResourceIds:              chunk,
+			ResourceType:             data.ResourceType.ValueEnum(),
+			TagSpecifications:        tagSpecifications,
+		}
+
+		if v := fwflex.StringValueFromFramework(ctx, data.LogDestination); v != "" {
+			input.LogDestination = aws.String(strings.TrimSuffix(v, ":*"))
+		}
+
+		if resourceType := input.ResourceType; resourceType != awstypes.FlowLogsResourceTypeTransitGateway && resourceType != awstypes.FlowLogsResourceTypeTransitGatewayAttachment {
+			input.TrafficType = data.TrafficType.ValueEnum()
+		}
+
+		if !data.DestinationOptions.IsNull() {
+			var destinationOptions awstypes.DestinationOptionsRequest
+			if diags := fwflex.Expand(ctx, data.DestinationOptions, &destinationOptions); diags.HasError() {
+				return flowLogIDs, fwdiag.DiagnosticsError(diags)
+			}
+			input.DestinationOptions = &destinationOptions
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout, func(ctx context.Context) (any, error) {
+			return conn.CreateFlowLogs(ctx, &input)
+		}, errCodeInvalidParameter, "Unable to assume given IAM role")
+
+		if err != nil {
+			return flowLogIDs, err
+		}
+
+		output := outputRaw.(*ec2.CreateFlowLogsOutput)
+
+		if len(output.FlowLogIds) > 0 {
+			// The response does not correlate flow log IDs with resource IDs.
+			flowLogs, err := findFlowLogsByIDs(ctx, conn, output.FlowLogIds)
+
+			if err != nil {
+				return flowLogIDs, err
+			}
+
+			for _, v := range flowLogs {
+				flowLogIDs[aws.ToString(v.ResourceId)] = aws.ToString(v.FlowLogId)
+			}
+		}
+
+		if err := unsuccessfulItemsError(output.Unsuccessful); err != nil {
+			return flowLogIDs, err
+		}
+	}
+
+	return flowLogIDs, nil
+}
+
+func deleteFlowLogs(ctx context.Context, conn *ec2.Client, ids []string) error {
+	for chunk := range slices.Chunk(ids, flowLogsCreateBatchSize) {
+		input := ec2.DeleteFlowLogsInput{
+			FlowLogIds: chunk,
+		}
+		output, err := conn.DeleteFlowLogs(ctx, &input)
+
+		if err == nil && output != nil {
+			err = unsuccessfulItemsError(slices.DeleteFunc(output.Unsuccessful, func(v awstypes.UnsuccessfulItem) bool {
+				return v.Error != nil && aws.ToString(v.Error.Code) == errCodeInvalidFlowLogIdNotFound
+			}))
+		}
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidFlowLogIdNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type flowLogsResourceModel struct {
+	framework.WithRegionModel
+	DeliverCrossAccountRole fwtypes.ARN                                                     `tfsdk:"deliver_cross_account_role"`
+	DestinationOptions      fwtypes.ListNestedObjectValueOf[flowLogDestinationOptionsModel] `tfsdk:"destination_options"`
+	FlowLogIDs              fwtypes.MapOfString                                             `tfsdk:"flow_log_ids"`
+	IAMRoleARN              fwtypes.ARN                                                     `tfsdk:"iam_role_arn"`
+	ID                      types.String                                                    `tfsdk:"id"`
+	LogDestination          types.String                                                    `tfsdk:"log_destination"`
+	LogDestinationType      fwtypes.StringEnum[awstypes.LogDestinationType]                 `tfsdk:"log_destination_type"`
+	LogFormat               types.String                                                    `tfsdk:"log_format"`
+	MaxAggregationInterval  types.Int32                                                     `tfsdk:"max_aggregation_interval"`
+	ResourceIDs             fwtypes.SetOfString                                             `tfsdk:"resource_ids"`
+	ResourceType            fwtypes.StringEnum[awstypes.FlowLogsResourceType]               `tfsdk:"resource_type"`
+	Tags                    tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                      `tfsdk:"tags_all"`
+	TrafficType             fwtypes.StringEnum[awstypes.TrafficType]                        `tfsdk:"traffic_type"`
+}
+
+// flattenCommon sets the attributes shared by every flow log in the set.
+func (m *flowLogsResourceModel) flattenCommon(ctx context.Context, apiObject *awstypes.FlowLog) (diags diag.Diagnostics) {
+	m.DeliverCrossAccountRole = fwflex.StringToFrameworkARN(ctx, apiObject.DeliverCrossAccountRole)
+	if apiObject.DestinationOptions != nil {
+		diags.Append(fwflex.Flatten(ctx, apiObject.DestinationOptions, &m.DestinationOptions)...)
+	} else {
+		m.DestinationOptions = fwtypes.NewListNestedObjectValueOfNull[flowLogDestinationOptionsModel](ctx)
+	}
+	m.IAMRoleARN = fwflex.StringToFrameworkARN(ctx, apiObject.DeliverLogsPermissionArn)
+	m.LogDestination = fwflex.StringToFramework(ctx, apiObject.LogDestination)
+	m.LogDestinationType = fwtypes.StringEnumValue(apiObject.LogDestinationType)
+	m.LogFormat = fwflex.StringToFramework(ctx, apiObject.LogFormat)
+	m.MaxAggregationInterval = fwflex.Int32ToFramework(ctx, apiObject.MaxAggregationInterval)
+	if !strings.HasPrefix(aws.ToString(apiObject.ResourceId), "tgw-") {
+		m.TrafficType = fwtypes.StringEnumValue(apiObject.TrafficType)
+	}
+
+	return diags
+}
+
+type flowLogDestinationOptionsModel struct {
+	FileFormat               fwtypes.StringEnum[awstypes.DestinationFileFormat] `tfsdk:"file_format"`
+	HiveCompatiblePartitions types.Bool                                         `tfsdk:"hive_compatible_partitions"`
+	PerHourPartition         types.Bool                                         `tfsdk:"per_hour_partition"`
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_ipam_external_resource_verification_token", name="IPAM External Resource Verification Token")
+// @Tags(identifierAttribute="id")
+// @Testing(tagsTest=false)
+func newIPAMExternalResourceVerificationTokenResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ipamExternalResourceVerificationTokenResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type ipamExternalResourceVerificationTokenResource struct {
+	framework.ResourceWithModel[ipamExternalResourceVerificationTokenResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *ipamExternalResourceVerificationTokenResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"ipam_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipam_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipam_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IpamExternalResourceVerificationTokenState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TokenState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_value": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ipamExternalResourceVerificationTokenResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ipamExternalResourceVerificationTokenResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := ec2.CreateIpamExternalResourceVerificationTokenInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		IpamId:            fwflex.StringFromFramework(ctx, data.IPAMID),
+		TagSpecifications: getTagSpecificationsIn(ctx, awstypes.ResourceTypeIpamExternalResourceVerificationToken),
+	}
+
+	output, err := conn.CreateIpamExternalResourceVerificationToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IPAM (%s) External Resource Verification Token", data.IPAMID.ValueString()), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.IpamExternalResourceVerificationToken.IpamExternalResourceVerificationTokenId)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	token, err := waitIPAMExternalResourceVerificationTokenCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IPAM External Resource Verification Token (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(r.flatten(ctx, token, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ipamExternalResourceVerificationTokenResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ipamExternalResourceVerificationTokenResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	token, err := findIPAMExternalResourceVerificationTokenByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IPAM External Resource Verification Token (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, token, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, token.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ipamExternalResourceVerificationTokenResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new ipamExternalResourceVerificationTokenResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ipamExternalResourceVerificationTokenResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ipamExternalResourceVerificationTokenResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := ec2.DeleteIpamExternalResourceVerificationTokenInput{
+		IpamExternalResourceVerificationTokenId: aws.String(id),
+	}
+	_, err := conn.DeleteIpamExternalResourceVerificationToken(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMExternalResourceVerificationTokenIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IPAM External Resource Verification Token (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitIPAMExternalResourceVerificationTokenDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IPAM External Resource Verification Token (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *ipamExternalResourceVerificationTokenResource) flatten(ctx context.Context, token *awstypes.IpamExternalResourceVerificationToken, data *ipamExternalResourceVerificationTokenResourceModel) diag.Diagnostics {
+	diags := fwflex.Flatten(ctx, token, data)
+
+	data.ARN = fwflex.StringToFramework(ctx, token.IpamExternalResourceVerificationTokenArn)
+	data.ID = fwflex.StringToFramework(ctx, token.IpamExternalResourceVerificationTokenId)
+
+	return diags
+}
+
+type ipamExternalResourceVerificationTokenResourceModel struct {
+	framework.WithRegionModel
+	ARN        types.String                                                            `tfsdk:"arn"`
+	ID         types.String                                                            `tfsdk:"id"`
+	IPAMARN    types.String                                                            `tfsdk:"ipam_arn"`
+	IPAMID     types.String                                                            `tfsdk:"ipam_id"`
+	IPAMRegion types.String                                                            `tfsdk:"ipam_region"`
+	NotAfter   timetypes.RFC3339                                                       `tfsdk:"not_after"`
+	State      fwtypes.StringEnum[awstypes.IpamExternalResourceVerificationTokenState] `tfsdk:"state"`
+	Status     fwtypes.StringEnum[awstypes.TokenState]                                 `tfsdk:"status"`
+	Tags       tftags.Map                                                              `tfsdk:"tags"`
+	TagsAll    tftags.Map                                                              `tfsdk:"tags_all"`
+	Timeouts   timeouts.Value                                                          `tfsdk:"timeouts"`
+	TokenName  types.String                                                            `tfsdk:"token_name"`
+	TokenValue types.String                                                            `tfsdk:"token_value"`
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIPAMExternalResourceVerificationToken_basic(t *testing.T) { // nosemgrep:ci.vpc-in-test-name
+	ctx := acctest.Context(t)
+	var v awstypes.IpamExternalResourceVerificationToken
+	resourceName := "aws_vpc_ipam_external_resource_verification_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMExternalResourceVerificationTokenDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMExternalResourceVerificationTokenConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAMExternalResourceVerificationTokenExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`ipam-external-resource-verification-token/ipamext-res-ver-token-[0-9a-f]+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_arn", "aws_vpc_ipam.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_id", "aws_vpc_ipam.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_region", "data.aws_region.current", names.AttrRegion),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.IpamExternalResourceVerificationTokenStateCreateComplete)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.TokenStateValid)),
+					resource.TestCheckResourceAttrSet(resourceName, "token_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "token_value"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccIPAMExternalResourceVerificationToken_disappears(t *testing.T) { // nosemgrep:ci.vpc-in-test-name
+	ctx := acctest.Context(t)
+	var v awstypes.IpamExternalResourceVerificationToken
+	resourceName := "aws_vpc_ipam_external_resource_verification_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMExternalResourceVerificationTokenDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMExternalResourceVerificationTokenConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMExternalResourceVerificationTokenExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceIPAMExternalResourceVerificationToken, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIPAMExternalResourceVerificationToken_tags(t *testing.T) { // nosemgrep:ci.vpc-in-test-name
+	ctx := acctest.Context(t)
+	var v awstypes.IpamExternalResourceVerificationToken
+	resourceName := "aws_vpc_ipam_external_resource_verification_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMExternalResourceVerificationTokenDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMExternalResourceVerificationTokenConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAMExternalResourceVerificationTokenExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccIPAMExternalResourceVerificationTokenConfig_tags2(acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAMExternalResourceVerificationTokenExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccIPAMExternalResourceVerificationTokenConfig_tags1(acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAMExternalResourceVerificationTokenExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIPAMExternalResourceVerificationTokenExists(ctx context.Context, n string, v *awstypes.IpamExternalResourceVerificationToken) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindIPAMExternalResourceVerificationTokenByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIPAMExternalResourceVerificationTokenDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_ipam_external_resource_verification_token" {
+				continue
+			}
+
+			_, err := tfec2.FindIPAMExternalResourceVerificationTokenByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IPAM External Resource Verification Token still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+var testAccIPAMExternalResourceVerificationTokenConfig_basic = acctest.ConfigCompose(testAccIPAMConfig_basic, `
+resource "aws_vpc_ipam_external_resource_verification_token" "test" {
+  ipam_id = aws_vpc_ipam.test.id
+}
+`)
+
+func testAccIPAMExternalResourceVerificationTokenConfig_tags1(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccIPAMConfig_basic, fmt.Sprintf(`
+resource "aws_vpc_ipam_external_resource_verification_token" "test" {
+  ipam_id = aws_vpc_ipam.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccIPAMExternalResourceVerificationTokenConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccIPAMConfig_basic, fmt.Sprintf(`
+resource "aws_vpc_ipam_external_resource_verification_token" "test" {
+  ipam_id = aws_vpc_ipam.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -55,10 +56,11 @@ func resourceIPAMPoolCIDR() *schema.Resource {
 				),
 			},
 			"cidr_authorization_context": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"ipam_external_resource_verification_token_id"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrMessage: {
@@ -74,6 +76,12 @@ func resourceIPAMPoolCIDR() *schema.Resource {
 					},
 				},
 			},
+			"ipam_external_resource_verification_token_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr_authorization_context"},
+			},
 			// This resource's ID is a concatenated id of `<cidr>_<poolid>`
 			// ipam_pool_cidr_id was not part of the initial feature release
 			"ipam_pool_cidr_id": {
@@ -93,6 +101,12 @@ func resourceIPAMPoolCIDR() *schema.Resource {
 				ValidateFunc:  validation.IntBetween(0, 128),
 				ConflictsWith: []string{"cidr"},
 			},
+			"verification_method": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.VerificationMethod](),
+			},
 		},
 	}
 }
@@ -114,10 +128,18 @@ func resourceIPAMPoolCIDRCreate(ctx context.Context, d *schema.ResourceData, met
 		input.CidrAuthorizationContext = expandIPAMCIDRAuthorizationContext(v.([]any)[0].(map[string]any))
 	}
 
+	if v, ok := d.GetOk("ipam_external_resource_verification_token_id"); ok {
+		input.IpamExternalResourceVerificationTokenId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("netmask_length"); ok {
 		input.NetmaskLength = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("verification_method"); ok {
+		input.VerificationMethod = awstypes.VerificationMethod(v.(string))
+	}
+
 	output, err := conn.ProvisionIpamPoolCidr(ctx, input)
 
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -68,6 +69,29 @@ func TestAccIPAMPreviewNextCIDRDataSource_ipv4Allocated(t *testing.T) { // nosem
 	})
 }
 
+func TestAccIPAMPreviewNextCIDRDataSource_ipv6Basic(t *testing.T) { // nosemgrep:ci.vpc-in-test-name
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_vpc_ipam_preview_next_cidr.test"
+	netmaskLength := "56"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPreviewNextCIDRDataSourceConfig_ipv6(netmaskLength),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(datasourceName, "cidr", regexache.MustCompile(`/56$`)),
+					resource.TestCheckResourceAttrPair(datasourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", names.AttrID),
+					resource.TestCheckResourceAttr(datasourceName, "netmask_length", netmaskLength),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIPAMPreviewNextCIDRDataSource_ipv4DisallowedCIDR(t *testing.T) { // nosemgrep:ci.vpc-in-test-name
 	ctx := acctest.Context(t)
 	datasourceName := "data.aws_vpc_ipam_preview_next_cidr.test"
@@ -172,3 +196,38 @@ data "aws_vpc_ipam_preview_next_cidr" "test" {
 }
 `, netmaskLength, disallowedCidr))
 }
+
+func testAccIPAMPreviewNextCIDRDataSourceConfig_ipv6(netmaskLength string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+  operating_regions {
+    region_name = data.aws_region.current.region
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family   = "ipv6"
+  ipam_scope_id    = aws_vpc_ipam.test.public_default_scope_id
+  locale           = data.aws_region.current.region
+  public_ip_source = "amazon"
+  aws_service      = "ec2"
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  netmask_length = 52
+}
+
+data "aws_vpc_ipam_preview_next_cidr" "test" {
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  netmask_length = %[1]q
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+`, netmaskLength)
+}
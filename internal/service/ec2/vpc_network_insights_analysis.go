@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -37,6 +38,8 @@ func resourceNetworkInsightsAnalysis() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceNetworkInsightsAnalysisCustomizeDiff,
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"alternate_path_hints": {
@@ -59,6 +62,10 @@ func resourceNetworkInsightsAnalysis() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"expected_path_found": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				"explanations": networkInsightsAnalysisExplanationsSchema(),
 				"filter_in_arns": {
 					Type:     schema.TypeSet,
@@ -94,6 +101,12 @@ func resourceNetworkInsightsAnalysis() *schema.Resource {
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				"triggers": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
 				"wait_for_completion": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -1437,7 +1450,12 @@ func resourceNetworkInsightsAnalysisCreate(ctx context.Context, d *schema.Resour
 		}
 	}
 
-	return append(diags, resourceNetworkInsightsAnalysisRead(ctx, d, meta)...)
+	diags = append(diags, resourceNetworkInsightsAnalysisRead(ctx, d, meta)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, checkNetworkInsightsAnalysisExpectedPathFound(d)...)
 }
 
 func resourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -1483,8 +1501,21 @@ func resourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNetworkInsightsAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	// Tags only.
-	return resourceNetworkInsightsAnalysisRead(ctx, d, meta)
+	// Tags and expected_path_found only.
+	diags := resourceNetworkInsightsAnalysisRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.HasChange("expected_path_found") {
+		diags = append(diags, checkNetworkInsightsAnalysisExpectedPathFound(d)...)
+		if diags.HasError() {
+			// Keep the prior expected_path_found in state so the assertion is re-evaluated on the next apply.
+			d.Partial(true)
+		}
+	}
+
+	return diags
 }
 
 func resourceNetworkInsightsAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -1508,6 +1539,33 @@ func resourceNetworkInsightsAnalysisDelete(ctx context.Context, d *schema.Resour
 	return diags
 }
 
+func resourceNetworkInsightsAnalysisCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if v := diff.GetRawConfig().GetAttr("expected_path_found"); v.IsKnown() && !v.IsNull() {
+		if !diff.Get("wait_for_completion").(bool) {
+			return errors.New(`"expected_path_found" requires "wait_for_completion" to be true`)
+		}
+	}
+
+	return nil
+}
+
+// checkNetworkInsightsAnalysisExpectedPathFound returns an error diagnostic if the completed analysis
+// result does not match the configured expected_path_found value.
+func checkNetworkInsightsAnalysisExpectedPathFound(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v := d.GetRawConfig().GetAttr("expected_path_found")
+	if !v.IsKnown() || v.IsNull() {
+		return diags
+	}
+
+	if want, got := v.True(), d.Get("path_found").(bool); got != want {
+		return sdkdiag.AppendErrorf(diags, "EC2 Network Insights Analysis (%s) for path %s: path_found is %t, expected %t", d.Id(), d.Get("network_insights_path_id").(string), got, want)
+	}
+
+	return diags
+}
+
 func flattenAdditionalDetail(apiObject *awstypes.AdditionalDetail) map[string]any {
 	if apiObject == nil {
 		return nil
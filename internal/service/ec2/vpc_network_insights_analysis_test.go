@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -188,6 +189,72 @@ func TestAccVPCNetworkInsightsAnalysis_waitForCompletion(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsAnalysis_expectedPathFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCNetworkInsightsAnalysisConfig_expectedPathFoundNoWait(rName),
+				ExpectError: regexache.MustCompile(`"expected_path_found" requires "wait_for_completion" to be true`),
+			},
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expected_path_found", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "path_found", acctest.CtTrue),
+				),
+			},
+			{
+				Config:      testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName, false),
+				ExpectError: regexache.MustCompile(`path_found is true, expected false`),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAnalysis_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "1"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkInsightsAnalysisExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -315,3 +382,46 @@ resource "aws_ec2_network_insights_analysis" "test" {
 }
 `, rName, waitForCompletion))
 }
+
+func testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName string, expectedPathFound bool) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  expected_path_found      = %[2]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, expectedPathFound))
+}
+
+func testAccVPCNetworkInsightsAnalysisConfig_expectedPathFoundNoWait(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  expected_path_found      = true
+  wait_for_completion      = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, run string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  triggers = {
+    run = %[2]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, run))
+}
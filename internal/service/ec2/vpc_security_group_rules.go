@@ -0,0 +1,481 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules", name="Security Group Rules")
+func newSecurityGroupRulesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupRulesResource{}
+
+	return r, nil
+}
+
+const (
+	// Number of rules authorized, modified or revoked per API call.
+	securityGroupRulesBatchSize = 50
+)
+
+type securityGroupRulesResource struct {
+	framework.ResourceWithModel[securityGroupRulesResourceModel]
+	framework.WithImportByID
+}
+
+func (r *securityGroupRulesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	ruleBlock := func() schema.SetNestedBlock {
+		return schema.SetNestedBlock{
+			CustomType: fwtypes.NewSetNestedObjectTypeOf[securityGroupRulesRuleModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"cidr_ipv4": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							fwvalidators.IPv4CIDRNetworkAddress(),
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("cidr_ipv6"),
+								path.MatchRelative().AtParent().AtName("prefix_list_id"),
+								path.MatchRelative().AtParent().AtName("referenced_security_group_id"),
+							),
+						},
+					},
+					"cidr_ipv6": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							fwvalidators.IPv6CIDRNetworkAddress(),
+						},
+					},
+					names.AttrDescription: schema.StringAttribute{
+						Optional: true,
+					},
+					"from_port": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(-1, 65535),
+						},
+					},
+					"ip_protocol": schema.StringAttribute{
+						CustomType: ipProtocolType{},
+						Required:   true,
+					},
+					"prefix_list_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"referenced_security_group_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"to_port": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(-1, 65535),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress":  ruleBlock(),
+			"ingress": ruleBlock(),
+		},
+	}
+}
+
+func (r *securityGroupRulesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := fwflex.StringValueFromFramework(ctx, data.SecurityGroupID)
+	if err := r.reconcile(ctx, conn, &data); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group (%s) Rules", groupID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.SecurityGroupID
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *securityGroupRulesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := fwflex.StringValueFromFramework(ctx, data.ID)
+	_, err := findSecurityGroupByID(ctx, conn, groupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) Rules", groupID), err.Error())
+
+		return
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) Rules", groupID), err.Error())
+
+		return
+	}
+
+	accountID := r.Meta().AccountID(ctx)
+	for _, egress := range []bool{false, true} {
+		attr := &data.Ingress
+		if egress {
+			attr = &data.Egress
+		}
+
+		// Preserve the configured representation of equivalent rules, e.g. a null port or a numeric protocol.
+		prior, diags := securityGroupRulesByKey(ctx, *attr)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var models []*securityGroupRulesRuleModel
+		for _, rule := range rules {
+			if aws.ToBool(rule.IsEgress) != egress {
+				continue
+			}
+
+			model := flattenSecurityGroupRulesRule(ctx, &rule, accountID)
+			if v, ok := prior[model.key()]; ok {
+				v.Description = model.Description
+				model = v
+			}
+			models = append(models, model)
+		}
+
+		// Keep an unconfigured block null rather than empty.
+		if len(models) == 0 && attr.IsNull() {
+			continue
+		}
+
+		*attr = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, models)
+	}
+
+	data.SecurityGroupID = data.ID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := fwflex.StringValueFromFramework(ctx, new.ID)
+	if err := r.reconcile(ctx, conn, &new); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group (%s) Rules", groupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Revoke all rules.
+	groupID := fwflex.StringValueFromFramework(ctx, data.ID)
+	data.Egress = fwtypes.NewSetNestedObjectValueOfNull[securityGroupRulesRuleModel](ctx)
+	data.Ingress = fwtypes.NewSetNestedObjectValueOfNull[securityGroupRulesRuleModel](ctx)
+	err := r.reconcile(ctx, conn, &data)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Security Group (%s) Rules", groupID), err.Error())
+
+		return
+	}
+}
+
+// reconcile makes the security group's rules match the configured ingress and egress rules.
+// Rules not in configuration are revoked, rules whose description differs are modified and missing rules are authorized.
+func (r *securityGroupRulesResource) reconcile(ctx context.Context, conn *ec2.Client, data *securityGroupRulesResourceModel) error {
+	groupID := fwflex.StringValueFromFramework(ctx, data.SecurityGroupID)
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return err
+	}
+
+	accountID := r.Meta().AccountID(ctx)
+	var updates []awstypes.SecurityGroupRuleUpdate
+	for _, egress := range []bool{false, true} {
+		attr := data.Ingress
+		if egress {
+			attr = data.Egress
+		}
+
+		desired, diags := securityGroupRulesByKey(ctx, attr)
+		if diags.HasError() {
+			return fwdiag.DiagnosticsError(diags)
+		}
+
+		var revokeIDs []string
+		for _, rule := range rules {
+			if aws.ToBool(rule.IsEgress) != egress {
+				continue
+			}
+
+			model := flattenSecurityGroupRulesRule(ctx, &rule, accountID)
+			key := model.key()
+			v, ok := desired[key]
+			if !ok {
+				revokeIDs = append(revokeIDs, aws.ToString(rule.SecurityGroupRuleId))
+				continue
+			}
+
+			if !v.Description.Equal(model.Description) && !(v.Description.IsNull() && model.Description.ValueString() == "") {
+				updates = append(updates, awstypes.SecurityGroupRuleUpdate{
+					SecurityGroupRule:   v.securityGroupRuleResourceModel().expandSecurityGroupRuleRequest(ctx),
+					SecurityGroupRuleId: rule.SecurityGroupRuleId,
+				})
+			}
+			delete(desired, key)
+		}
+
+		if err := revokeSecurityGroupRules(ctx, conn, groupID, egress, revokeIDs); err != nil {
+			return err
+		}
+
+		var ipPermissions []awstypes.IpPermission
+		for _, v := range desired {
+			ipPermissions = append(ipPermissions, v.securityGroupRuleResourceModel().expandIPPermission(ctx))
+		}
+
+		if err := authorizeSecurityGroupRules(ctx, conn, groupID, egress, ipPermissions); err != nil {
+			return err
+		}
+	}
+
+	for chunk := range slices.Chunk(updates, securityGroupRulesBatchSize) {
+		input := ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(groupID),
+			SecurityGroupRules: chunk,
+		}
+		if _, err := conn.ModifySecurityGroupRules(ctx, &input); err != nil {
+			return fmt.Errorf("modifying rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func authorizeSecurityGroupRules(ctx context.Context, conn *ec2.Client, groupID string, egress bool, ipPermissions []awstypes.IpPermission) error {
+	for chunk := range slices.Chunk(ipPermissions, securityGroupRulesBatchSize) {
+		var err error
+		if egress {
+			input := ec2.AuthorizeSecurityGroupEgressInput{
+				GroupId:       aws.String(groupID),
+				IpPermissions: chunk,
+			}
+			_, err = conn.AuthorizeSecurityGroupEgress(ctx, &input)
+		} else {
+			input := ec2.AuthorizeSecurityGroupIngressInput{
+				GroupId:       aws.String(groupID),
+				IpPermissions: chunk,
+			}
+			_, err = conn.AuthorizeSecurityGroupIngress(ctx, &input)
+		}
+
+		if err != nil {
+			return fmt.Errorf("authorizing %s rules: %w", securityGroupRuleDirection(egress), err)
+		}
+	}
+
+	return nil
+}
+
+func revokeSecurityGroupRules(ctx context.Context, conn *ec2.Client, groupID string, egress bool, ids []string) error {
+	for chunk := range slices.Chunk(ids, securityGroupRulesBatchSize) {
+		var err error
+		if egress {
+			input := ec2.RevokeSecurityGroupEgressInput{
+				GroupId:              aws.String(groupID),
+				SecurityGroupRuleIds: chunk,
+			}
+			_, err = conn.RevokeSecurityGroupEgress(ctx, &input)
+		} else {
+			input := ec2.RevokeSecurityGroupIngressInput{
+				GroupId:              aws.String(groupID),
+				SecurityGroupRuleIds: chunk,
+			}
+			_, err = conn.RevokeSecurityGroupIngress(ctx, &input)
+		}
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("revoking %s rules: %w", securityGroupRuleDirection(egress), err)
+		}
+	}
+
+	return nil
+}
+
+func securityGroupRuleDirection(egress bool) string {
+	if egress {
+		return "egress"
+	}
+
+	return "ingress"
+}
+
+func securityGroupRulesByKey(ctx context.Context, v fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel]) (map[string]*securityGroupRulesRuleModel, diag.Diagnostics) {
+	models, diags := v.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	m := make(map[string]*securityGroupRulesRuleModel, len(models))
+	for _, model := range models {
+		m[model.key()] = model
+	}
+
+	return m, diags
+}
+
+func flattenSecurityGroupRulesRule(ctx context.Context, apiObject *awstypes.SecurityGroupRule, accountID string) *securityGroupRulesRuleModel {
+	return &securityGroupRulesRuleModel{
+		CIDRIPv4:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv4),
+		CIDRIPv6:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv6),
+		Description:               fwflex.StringToFramework(ctx, apiObject.Description),
+		FromPort:                  fwflex.Int32ToFrameworkInt64(ctx, apiObject.FromPort),
+		IPProtocol:                fwflex.StringToFrameworkValuable[ipProtocol](ctx, apiObject.IpProtocol),
+		PrefixListID:              fwflex.StringToFramework(ctx, apiObject.PrefixListId),
+		ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, apiObject.ReferencedGroupInfo, accountID),
+		ToPort:                    fwflex.Int32ToFrameworkInt64(ctx, apiObject.ToPort),
+	}
+}
+
+type securityGroupRulesResourceModel struct {
+	framework.WithRegionModel
+	Egress          fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"egress"`
+	ID              types.String                                                `tfsdk:"id"`
+	Ingress         fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"ingress"`
+	SecurityGroupID types.String                                                `tfsdk:"security_group_id"`
+}
+
+type securityGroupRulesRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                ipProtocol   `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
+
+// key identifies a rule independently of its description.
+func (model *securityGroupRulesRuleModel) key() string {
+	protocol := protocolForValue(model.IPProtocol.ValueString())
+	fromPort, toPort := int64(-1), int64(-1)
+	// Ports are ignored for the "all traffic" protocol.
+	if protocol != "-1" {
+		if !model.FromPort.IsNull() {
+			fromPort = model.FromPort.ValueInt64()
+		}
+		if !model.ToPort.IsNull() {
+			toPort = model.ToPort.ValueInt64()
+		}
+	}
+
+	return strings.Join([]string{
+		protocol,
+		strconv.FormatInt(fromPort, 10),
+		strconv.FormatInt(toPort, 10),
+		model.CIDRIPv4.ValueString(),
+		strings.ToLower(model.CIDRIPv6.ValueString()),
+		model.PrefixListID.ValueString(),
+		model.ReferencedSecurityGroupID.ValueString(),
+	}, "|")
+}
+
+func (model *securityGroupRulesRuleModel) securityGroupRuleResourceModel() *securityGroupRuleResourceModel {
+	return &securityGroupRuleResourceModel{
+		CIDRIPv4:                  model.CIDRIPv4,
+		CIDRIPv6:                  model.CIDRIPv6,
+		Description:               model.Description,
+		FromPort:                  model.FromPort,
+		IPProtocol:                model.IPProtocol,
+		PrefixListID:              model.PrefixListID,
+		ReferencedSecurityGroupID: model.ReferencedSecurityGroupID,
+		ToPort:                    model.ToPort,
+	}
+}
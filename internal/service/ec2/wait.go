@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const (
@@ -815,6 +816,27 @@ func waitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2.Client, i
 	return nil, err
 }
 
+func waitInstanceConnectEndpointUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ec2InstanceConnectEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		// The endpoint may still report its previous state just after the update is requested.
+		Pending: enum.Slice(awstypes.Ec2InstanceConnectEndpointStateCreateComplete, awstypes.Ec2InstanceConnectEndpointStateUpdateInProgress),
+		Target:  enum.Slice(awstypes.Ec2InstanceConnectEndpointStateUpdateComplete),
+		Refresh: statusInstanceConnectEndpoint(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Ec2InstanceConnectEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ec2InstanceConnectEndpoint, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.Ec2InstanceConnectEndpointStateDeleteInProgress),
@@ -2557,6 +2579,30 @@ func waitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.Client, trans
 	return nil, err
 }
 
+func waitTransitGatewayStaticRoutesSettled(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func(ctx context.Context) (bool, error) {
+		input := ec2.SearchTransitGatewayRoutesInput{
+			Filters: []awstypes.Filter{
+				newFilter(names.AttrType, enum.Slice(awstypes.TransitGatewayRouteTypeStatic)),
+				newFilter(names.AttrState, enum.Slice(awstypes.TransitGatewayRouteStateDeleting, awstypes.TransitGatewayRouteStatePending)),
+			},
+			MaxResults:                 aws.Int32(5),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+		output, err := findTransitGatewayRoutes(ctx, conn, &input)
+
+		if err != nil {
+			return false, err
+		}
+
+		return len(output) == 0, nil
+	},
+		tfresource.WaitOpts{
+			MinTimeout: 2 * time.Second,
+		},
+	)
+}
+
 func waitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayPolicyTable, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayPolicyTableStatePending),
@@ -3635,6 +3681,40 @@ func waitVPCBlockPublicAccessExclusionDeleted(ctx context.Context, conn *ec2.Cli
 	return nil, err
 }
 
+func waitIPAMExternalResourceVerificationTokenCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamExternalResourceVerificationToken, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IpamExternalResourceVerificationTokenStateCreateInProgress),
+		Target:  enum.Slice(awstypes.IpamExternalResourceVerificationTokenStateCreateComplete),
+		Refresh: statusIPAMExternalResourceVerificationToken(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.IpamExternalResourceVerificationToken); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIPAMExternalResourceVerificationTokenDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamExternalResourceVerificationToken, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IpamExternalResourceVerificationTokenStateCreateComplete, awstypes.IpamExternalResourceVerificationTokenStateDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusIPAMExternalResourceVerificationToken(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.IpamExternalResourceVerificationToken); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitRouteServerCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.RouteServer, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.RouteServerStatePending),
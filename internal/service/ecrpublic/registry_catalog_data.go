@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecrpublic
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecrpublic/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ecrpublic_registry_catalog_data", name="Registry Catalog Data")
+func ResourceRegistryCatalogData() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRegistryCatalogDataPut,
+		ReadWithoutTimeout:   resourceRegistryCatalogDataRead,
+		UpdateWithoutTimeout: resourceRegistryCatalogDataPut,
+		DeleteWithoutTimeout: resourceRegistryCatalogDataDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRegistryCatalogDataPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRPublicClient(ctx)
+
+	input := &ecrpublic.PutRegistryCatalogDataInput{
+		DisplayName: aws.String(d.Get("display_name").(string)),
+	}
+
+	_, err := conn.PutRegistryCatalogData(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting ECR Public Registry Catalog Data: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID(ctx))
+	}
+
+	return append(diags, resourceRegistryCatalogDataRead(ctx, d, meta)...)
+}
+
+func resourceRegistryCatalogDataRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRPublicClient(ctx)
+
+	output, err := FindRegistryCatalogData(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Public Registry Catalog Data (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Public Registry Catalog Data (%s): %s", d.Id(), err)
+	}
+
+	d.Set("display_name", output.DisplayName)
+	d.Set("registry_id", d.Id())
+
+	return diags
+}
+
+func resourceRegistryCatalogDataDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRPublicClient(ctx)
+
+	// Registry catalog data cannot be deleted, only cleared.
+	log.Printf("[DEBUG] Deleting ECR Public Registry Catalog Data: %s", d.Id())
+	input := &ecrpublic.PutRegistryCatalogDataInput{
+		DisplayName: aws.String(""),
+	}
+
+	_, err := conn.PutRegistryCatalogData(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ECR Public Registry Catalog Data (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRegistryCatalogData(ctx context.Context, conn *ecrpublic.Client) (*awstypes.RegistryCatalogData, error) {
+	input := &ecrpublic.GetRegistryCatalogDataInput{}
+
+	output, err := conn.GetRegistryCatalogData(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RegistryCatalogData == nil || aws.ToString(output.RegistryCatalogData.DisplayName) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RegistryCatalogData, nil
+}
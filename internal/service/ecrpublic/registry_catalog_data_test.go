@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecrpublic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecrpublic "github.com/hashicorp/terraform-provider-aws/internal/service/ecrpublic"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Registry catalog data is an account-level singleton, so these tests must not run in parallel.
+func TestAccECRPublicRegistryCatalogData_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix("tf-acc-test")
+	rName2 := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_registry_catalog_data.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRPublicServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistryCatalogDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryCatalogDataConfig_basic(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryCatalogDataExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName1),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, "registry_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegistryCatalogDataConfig_basic(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryCatalogDataExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRegistryCatalogDataDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ecrpublic_registry_catalog_data" {
+				continue
+			}
+
+			_, err := tfecrpublic.FindRegistryCatalogData(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ECR Public Registry Catalog Data %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRegistryCatalogDataExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicClient(ctx)
+
+		_, err := tfecrpublic.FindRegistryCatalogData(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccRegistryCatalogDataConfig_basic(displayName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_registry_catalog_data" "test" {
+  display_name = %[1]q
+}
+`, displayName)
+}
@@ -38,6 +38,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
 	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  ResourceRegistryCatalogData,
+			TypeName: "aws_ecrpublic_registry_catalog_data",
+			Name:     "Registry Catalog Data",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  ResourceRepository,
 			TypeName: "aws_ecrpublic_repository",
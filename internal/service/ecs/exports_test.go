@@ -23,7 +23,9 @@ var (
 	FindTaskDefinitionByFamilyOrARN         = findTaskDefinitionByFamilyOrARN
 	FindTaskSetNoTagsByThreePartKey         = findTaskSetNoTagsByThreePartKey
 	RoleNameFromARN                         = roleNameFromARN
+	ServiceDeploymentError                  = serviceDeploymentError
 	ServiceNameFromARN                      = serviceNameFromARN
 	TaskDefinitionARNStripRevision          = taskDefinitionARNStripRevision
+	TaskDefinitionFamily                    = taskDefinitionFamily
 	ValidTaskDefinitionContainerDefinitions = validTaskDefinitionContainerDefinitions
 )
@@ -6,7 +6,6 @@ package ecs
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
 	"math"
@@ -744,6 +743,11 @@ func resourceService() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"ignore_task_definition_revision_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"launch_type": {
 				Type:             schema.TypeString,
 				ForceNew:         true,
@@ -1564,13 +1568,17 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 	// you can specify only parameters that aren't controlled at the task set level
 	// hence TaskDefinition will not be set by aws sdk
 	if service.TaskDefinition != nil {
-		// Save task definition in the same format.
-		if arn.IsARN(d.Get("task_definition").(string)) {
+		if v := d.Get("task_definition").(string); d.Get("ignore_task_definition_revision_changes").(bool) && v != "" && taskDefinitionFamily(v) == taskDefinitionFamily(aws.ToString(service.TaskDefinition)) {
+			// Revisions of the same family registered outside Terraform (e.g. by CI) are ignored.
+			d.Set("task_definition", v)
+		} else if arn.IsARN(v) {
+			// Save task definition in the same format.
 			d.Set("task_definition", service.TaskDefinition)
 		} else {
 			d.Set("task_definition", familyAndRevisionFromTaskDefinitionARN(aws.ToString(service.TaskDefinition)))
 		}
 	}
+	d.Set("ignore_task_definition_revision_changes", d.Get("ignore_task_definition_revision_changes"))
 	d.Set(names.AttrTriggers, d.Get(names.AttrTriggers))
 	for _, deployment := range service.Deployments {
 		if aws.ToString(deployment.Status) == "PRIMARY" {
@@ -1601,7 +1609,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrForceDelete, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrForceDelete, "ignore_task_definition_revision_changes", names.AttrTags, names.AttrTagsAll) {
 		cluster := d.Get("cluster").(string)
 		input := ecs.UpdateServiceInput{
 			Cluster:            aws.String(cluster),
@@ -2226,21 +2234,50 @@ func findPrimaryDeploymentARN(ctx context.Context, conn *ecs.Client, primaryTask
 	return nil, nil
 }
 
-func findDeploymentStatus(ctx context.Context, conn *ecs.Client, deploymentArn string) (string, error) {
+func findServiceDeploymentByARN(ctx context.Context, conn *ecs.Client, deploymentARN string) (*awstypes.ServiceDeployment, error) {
 	input := ecs.DescribeServiceDeploymentsInput{
-		ServiceDeploymentArns: []string{deploymentArn},
+		ServiceDeploymentArns: []string{deploymentARN},
 	}
 
 	output, err := conn.DescribeServiceDeployments(ctx, &input)
+
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	if output == nil || len(output.ServiceDeployments) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &output.ServiceDeployments[0], nil
+}
+
+func statusServiceDeployment(ctx context.Context, conn *ecs.Client, deploymentARN string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findServiceDeploymentByARN(ctx, conn, deploymentARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
 	}
+}
 
-	if len(output.ServiceDeployments) == 0 {
+func findDeploymentStatus(ctx context.Context, conn *ecs.Client, deploymentArn string) (string, error) {
+	deployment, err := findServiceDeploymentByARN(ctx, conn, deploymentArn)
+
+	if tfresource.NotFound(err) {
 		return serviceStatusPending, nil
 	}
 
-	deployment := output.ServiceDeployments[0]
+	if err != nil {
+		return "", err
+	}
 
 	switch deployment.Status {
 	case awstypes.ServiceDeploymentStatusSuccessful:
@@ -2250,16 +2287,41 @@ func findDeploymentStatus(ctx context.Context, conn *ecs.Client, deploymentArn s
 	case awstypes.ServiceDeploymentStatusStopped,
 		awstypes.ServiceDeploymentStatusRollbackFailed,
 		awstypes.ServiceDeploymentStatusRollbackSuccessful:
-		message := "Deployment failed"
-		if deployment.StatusReason != nil {
-			message = aws.ToString(deployment.StatusReason)
-		}
-		return "", errors.New(message)
+		return "", serviceDeploymentError(deployment)
 	default:
 		return serviceStatusPending, nil
 	}
 }
 
+// serviceDeploymentError returns an error describing why the specified service deployment did not succeed,
+// including any deployment circuit breaker, CloudWatch alarm or rollback details.
+func serviceDeploymentError(deployment *awstypes.ServiceDeployment) error {
+	message := "Deployment failed"
+	if deployment.StatusReason != nil {
+		message = aws.ToString(deployment.StatusReason)
+	}
+
+	details := []string{fmt.Sprintf("status: %s", deployment.Status)}
+
+	if v := deployment.DeploymentCircuitBreaker; v != nil && v.Status == awstypes.ServiceDeploymentRollbackMonitorsStatusTriggered {
+		details = append(details, fmt.Sprintf("deployment circuit breaker triggered after %d of %d failed tasks", v.FailureCount, v.Threshold))
+	}
+
+	if v := deployment.Alarms; v != nil && v.Status == awstypes.ServiceDeploymentRollbackMonitorsStatusTriggered {
+		details = append(details, fmt.Sprintf("CloudWatch alarms triggered: %s", strings.Join(v.TriggeredAlarmNames, ", ")))
+	}
+
+	if v := deployment.Rollback; v != nil {
+		detail := fmt.Sprintf("rolled back to %s", aws.ToString(v.ServiceRevisionArn))
+		if v.Reason != nil {
+			detail = fmt.Sprintf("%s (%s)", detail, aws.ToString(v.Reason))
+		}
+		details = append(details, detail)
+	}
+
+	return fmt.Errorf("%s: %s", message, strings.Join(details, "; "))
+}
+
 type rollbackState struct {
 	rollbackConfigured     bool
 	rollbackRoutineStarted bool
@@ -2289,11 +2351,11 @@ func rollbackRoutine(ctx context.Context, conn *ecs.Client, rollbackState *rollb
 
 func rollbackDeployment(ctx context.Context, conn *ecs.Client, primaryDeploymentArn *string) error {
 	// Check if deployment is already in terminal state, meaning rollback is not needed
-	deploymentStatus, err := findDeploymentStatus(ctx, conn, *primaryDeploymentArn)
+	deployment, err := findServiceDeploymentByARN(ctx, conn, *primaryDeploymentArn)
 	if err != nil {
 		return err
 	}
-	if slices.Contains(deploymentTerminalStates, deploymentStatus) {
+	if slices.Contains(deploymentTerminalStates, string(deployment.Status)) {
 		return nil
 	}
 
@@ -2320,11 +2382,8 @@ func waitForDeploymentTerminalStatus(ctx context.Context, conn *ecs.Client, prim
 			awstypes.ServiceDeploymentStatusRollbackRequested,
 			awstypes.ServiceDeploymentStatusRollbackInProgress,
 		),
-		Target: deploymentTerminalStates,
-		Refresh: func() (any, string, error) {
-			status, err := findDeploymentStatus(ctx, conn, primaryDeploymentArn)
-			return nil, status, err
-		},
+		Target:  deploymentTerminalStates,
+		Refresh: statusServiceDeployment(ctx, conn, primaryDeploymentArn),
 		Timeout: 1 * time.Hour, // Maximum time before SIGKILL
 	}
 
@@ -3561,6 +3620,18 @@ func familyAndRevisionFromTaskDefinitionARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
 
+// taskDefinitionFamily returns the family of a task definition specified as
+// a family, a family and revision (family:revision) or a full ARN with or without revision.
+func taskDefinitionFamily(s string) string {
+	if arn.IsARN(s) {
+		s = familyAndRevisionFromTaskDefinitionARN(s)
+	}
+
+	family, _, _ := strings.Cut(s, ":")
+
+	return family
+}
+
 // roleNameFromARN parses a role name from a fully qualified ARN
 //
 // When providing a role name with a path, it must be prefixed with the full path
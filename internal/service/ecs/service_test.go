@@ -138,6 +138,65 @@ func TestServiceNameFromARN(t *testing.T) {
 	}
 }
 
+func TestServiceDeploymentError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		deployment awstypes.ServiceDeployment
+		expected   string
+	}{
+		{
+			name: "no details",
+			deployment: awstypes.ServiceDeployment{
+				Status: awstypes.ServiceDeploymentStatusStopped,
+			},
+			expected: "Deployment failed: status: STOPPED",
+		},
+		{
+			name: "circuit breaker rollback",
+			deployment: awstypes.ServiceDeployment{
+				Status:       awstypes.ServiceDeploymentStatusRollbackSuccessful,
+				StatusReason: aws.String("Service deployment rolled back"),
+				DeploymentCircuitBreaker: &awstypes.ServiceDeploymentCircuitBreaker{
+					FailureCount: 3,
+					Status:       awstypes.ServiceDeploymentRollbackMonitorsStatusTriggered,
+					Threshold:    3,
+				},
+				Rollback: &awstypes.Rollback{
+					Reason:             aws.String("circuit breaker"),
+					ServiceRevisionArn: aws.String("revision-1"),
+				},
+			},
+			expected: "Service deployment rolled back: status: ROLLBACK_SUCCESSFUL; deployment circuit breaker triggered after 3 of 3 failed tasks; rolled back to revision-1 (circuit breaker)",
+		},
+		{
+			name: "alarms triggered",
+			deployment: awstypes.ServiceDeployment{
+				Status: awstypes.ServiceDeploymentStatusRollbackFailed,
+				Alarms: &awstypes.ServiceDeploymentAlarms{
+					Status:              awstypes.ServiceDeploymentRollbackMonitorsStatusTriggered,
+					TriggeredAlarmNames: []string{"alarm-1", "alarm-2"},
+				},
+				DeploymentCircuitBreaker: &awstypes.ServiceDeploymentCircuitBreaker{
+					Status: awstypes.ServiceDeploymentRollbackMonitorsStatusMonitoring,
+				},
+			},
+			expected: "Deployment failed: status: ROLLBACK_FAILED; CloudWatch alarms triggered: alarm-1, alarm-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfecs.ServiceDeploymentError(&tt.deployment).Error(); got != tt.expected {
+				t.Errorf("Expected: %s, Got: %s", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestAccECSService_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service awstypes.Service
@@ -746,6 +805,35 @@ func TestAccECSService_familyAndRevision(t *testing.T) {
 	})
 }
 
+func TestAccECSService_ignoreTaskDefinitionRevisionChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_ignoreTaskDefinitionRevisionChanges(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "ignore_task_definition_revision_changes", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "task_definition", "aws_ecs_task_definition.test", "arn_without_revision"),
+					testAccCheckServiceRegisterTaskDefinitionRevision(ctx, &service),
+				),
+			},
+			{
+				Config:   testAccServiceConfig_ignoreTaskDefinitionRevisionChanges(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccECSService_healthCheckGracePeriodSeconds(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service awstypes.Service
@@ -2862,6 +2950,38 @@ func testAccCheckServiceDisableServiceConnect(ctx context.Context, service *awst
 	}
 }
 
+// testAccCheckServiceRegisterTaskDefinitionRevision registers a new revision of the service's
+// task definition and deploys it, as a CI pipeline would.
+func testAccCheckServiceRegisterTaskDefinitionRevision(ctx context.Context, service *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		taskDefinition, _, err := tfecs.FindTaskDefinitionByFamilyOrARN(ctx, conn, aws.ToString(service.TaskDefinition))
+
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.RegisterTaskDefinition(ctx, &ecs.RegisterTaskDefinitionInput{
+			ContainerDefinitions: taskDefinition.ContainerDefinitions,
+			Family:               taskDefinition.Family,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		input := &ecs.UpdateServiceInput{
+			Cluster:        service.ClusterArn,
+			Service:        service.ServiceName,
+			TaskDefinition: output.TaskDefinition.TaskDefinitionArn,
+		}
+
+		_, err = conn.UpdateService(ctx, input)
+		return err
+	}
+}
+
 func testAccCheckServiceRemoveBlueGreenDeploymentConfigurations(ctx context.Context, service *awstypes.Service) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
@@ -5327,6 +5447,39 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_ignoreTaskDefinitionRevisionChanges(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  track_latest = true
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "jenkins:latest",
+    "memory": 128,
+    "name": "jenkins"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name                                    = %[1]q
+  cluster                                 = aws_ecs_cluster.test.id
+  task_definition                         = aws_ecs_task_definition.test.arn_without_revision
+  desired_count                           = 1
+  ignore_task_definition_revision_changes = true
+}
+`, rName)
+}
+
 func testAccServiceConfig_clusterName(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -70,6 +70,38 @@ func Test_StripRevision(t *testing.T) {
 	}
 }
 
+func TestTaskDefinitionFamily(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"family", "my-task", "my-task"},
+		{"family and revision", "my-task:42", "my-task"},
+		{
+			"arn with revision",
+			"arn:aws:ecs:us-east-1:000000000000:task-definition/my-task:42", //lintignore:AWSAT003,AWSAT005
+			"my-task",
+		},
+		{
+			"arn without revision",
+			"arn:aws:ecs:us-east-1:000000000000:task-definition/my-task", //lintignore:AWSAT003,AWSAT005
+			"my-task",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tfecs.TaskDefinitionFamily(tc.s); got != tc.want {
+				t.Errorf("TaskDefinitionFamily() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestValidTaskDefinitionContainerDefinitions(t *testing.T) {
 	t.Parallel()
 
@@ -150,6 +150,14 @@ func resourceAccessPointCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.RootDirectory = expandAccessPointRootDirectory(v.([]any))
 	}
 
+	// EFS only creates the root directory if creation_info is specified. Mounting the access point fails if the directory doesn't exist.
+	// Whether the directory already exists can't be determined through the EFS API, so warn rather than error.
+	if v := input.RootDirectory; v != nil && v.CreationInfo == nil {
+		if path := aws.ToString(v.Path); path != "" && path != "/" {
+			diags = sdkdiag.AppendWarningf(diags, "EFS Access Point root directory (%s) has no creation_info; if the directory does not already exist in File System (%s), clients will fail to mount the access point", path, fsID)
+		}
+	}
+
 	output, err := conn.CreateAccessPoint(ctx, input)
 
 	if err != nil {
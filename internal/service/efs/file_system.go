@@ -139,6 +139,11 @@ func resourceFileSystem() *schema.Resource {
 								awstypes.ReplicationOverwriteProtectionEnabled,
 								awstypes.ReplicationOverwriteProtectionDisabled,
 							), false),
+							// A replication destination file system is read-only and reports REPLICATING until the replication configuration is deleted.
+							// Its protection can't be changed in the meantime.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return old == string(awstypes.ReplicationOverwriteProtectionReplicating)
+							},
 						},
 					},
 				},
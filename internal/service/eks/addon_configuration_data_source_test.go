@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAddonConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_eks_addon_configuration.test"
+	versionDataSourceName := "data.aws_eks_addon_version.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfigurationDataSourceConfig_basic(addonName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "addon_name", addonName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "addon_version", versionDataSourceName, names.AttrVersion),
+					resource.TestCheckResourceAttrSet(dataSourceName, "configuration_schema"),
+					resource.TestCheckResourceAttr(dataSourceName, "pod_identity_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "pod_identity_configuration.0.service_account", "aws-node"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddonConfigurationDataSourceConfig_basic(addonName string) string {
+	return fmt.Sprintf(`
+data "aws_eks_addon_version" "test" {
+  addon_name         = %[1]q
+  kubernetes_version = "1.31"
+  most_recent        = true
+}
+
+data "aws_eks_addon_configuration" "test" {
+  addon_name    = data.aws_eks_addon_version.test.addon_name
+  addon_version = data.aws_eks_addon_version.test.version
+}
+`, addonName)
+}
@@ -42,7 +42,7 @@ func resourceFargateProfile() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -129,11 +129,26 @@ func resourceFargateProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	// Retry for IAM eventual consistency on error:
-	// InvalidParameterException: Misconfigured PodExecutionRole Trust Policy; Please add the eks-fargate-pods.amazonaws.com Service Principal
-	_, err := tfresource.RetryWhenIsAErrorMessageContains[any, *types.InvalidParameterException](ctx, propagationTimeout, func(ctx context.Context) (any, error) {
-		return conn.CreateFargateProfile(ctx, input)
-	}, "Misconfigured PodExecutionRole Trust Policy")
+	_, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutCreate),
+		func(ctx context.Context) (any, error) {
+			return conn.CreateFargateProfile(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// Retry for IAM eventual consistency on error:
+			// InvalidParameterException: Misconfigured PodExecutionRole Trust Policy; Please add the eks-fargate-pods.amazonaws.com Service Principal
+			if errs.IsAErrorMessageContains[*types.InvalidParameterException](err, "Misconfigured PodExecutionRole Trust Policy") {
+				return true, err
+			}
+
+			// Only one Fargate profile per cluster can be in the CREATING or DELETING status at a time, including profiles managed outside of this configuration:
+			// ResourceInUseException: Cannot create Fargate Profile ... because cluster ... currently has Fargate profile ... in status DELETING
+			if isFargateProfileInUseError(err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EKS Fargate Profile (%s): %s", profileID, err)
@@ -205,10 +220,21 @@ func resourceFargateProfileDelete(ctx context.Context, d *schema.ResourceData, m
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	log.Printf("[DEBUG] Deleting EKS Fargate Profile: %s", d.Id())
-	_, err = conn.DeleteFargateProfile(ctx, &eks.DeleteFargateProfileInput{
-		ClusterName:        aws.String(clusterName),
-		FargateProfileName: aws.String(fargateProfileName),
-	})
+	_, err = tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutDelete),
+		func(ctx context.Context) (any, error) {
+			return conn.DeleteFargateProfile(ctx, &eks.DeleteFargateProfileInput{
+				ClusterName:        aws.String(clusterName),
+				FargateProfileName: aws.String(fargateProfileName),
+			})
+		},
+		func(err error) (bool, error) {
+			if isFargateProfileInUseError(err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
 		return diags
@@ -225,6 +251,10 @@ func resourceFargateProfileDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func isFargateProfileInUseError(err error) bool {
+	return errs.IsAErrorMessageContains[*types.ResourceInUseException](err, "currently has Fargate profile")
+}
+
 func findFargateProfileByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, fargateProfileName string) (*types.FargateProfile, error) {
 	input := &eks.DescribeFargateProfileInput{
 		ClusterName:        aws.String(clusterName),
@@ -149,6 +149,43 @@ func TestAccEKSFargateProfile_Selector_labels(t *testing.T) {
 	})
 }
 
+func TestAccEKSFargateProfile_Selector_wildcard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fargateProfile1 types.FargateProfile
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_fargate_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartition(t, endpoints.AwsPartitionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFargateProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFargateProfileConfig_selectorWildcard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFargateProfileExists(ctx, resourceName, &fargateProfile1),
+					resource.TestCheckResourceAttr(resourceName, "selector.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "selector.*", map[string]string{
+						names.AttrNamespace: "test-*",
+						"labels.%":          "1",
+						"labels.app":        "web-?",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEKSFargateProfile_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fargateProfile1, fargateProfile2, fargateProfile3 types.FargateProfile
@@ -509,6 +546,29 @@ resource "aws_eks_fargate_profile" "test" {
 `, rName, labelKey1, labelValue1))
 }
 
+func testAccFargateProfileConfig_selectorWildcard(rName string) string {
+	return acctest.ConfigCompose(testAccFargateProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_fargate_profile" "test" {
+  cluster_name           = aws_eks_cluster.test.name
+  fargate_profile_name   = %[1]q
+  pod_execution_role_arn = aws_iam_role.pod.arn
+  subnet_ids             = aws_subnet.private[*].id
+
+  selector {
+    labels = {
+      app = "web-?"
+    }
+    namespace = "test-*"
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.pod-AmazonEKSFargatePodExecutionRolePolicy,
+    aws_route_table_association.private,
+  ]
+}
+`, rName))
+}
+
 func testAccFargateProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccFargateProfileConfig_base(rName), fmt.Sprintf(`
 resource "aws_eks_fargate_profile" "test" {
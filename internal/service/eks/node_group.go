@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -156,6 +157,30 @@ func resourceNodeGroup() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"max_parallel_nodes_repaired_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"node_repair_config.0.max_parallel_nodes_repaired_percentage"},
+						},
+						"max_parallel_nodes_repaired_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"node_repair_config.0.max_parallel_nodes_repaired_count"},
+						},
+						"max_unhealthy_node_threshold_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"node_repair_config.0.max_unhealthy_node_threshold_percentage"},
+						},
+						"max_unhealthy_node_threshold_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"node_repair_config.0.max_unhealthy_node_threshold_count"},
+						},
 					},
 				},
 			},
@@ -301,6 +326,12 @@ func resourceNodeGroup() *schema.Resource {
 								"update_config.0.max_unavailable_percentage",
 							},
 						},
+						"update_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.NodegroupUpdateStrategies](),
+						},
 					},
 				},
 			},
@@ -737,10 +768,28 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, no
 }
 
 func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
+	// Rolling updates of large node groups can take a long time, so log progress on each refresh.
+	start := time.Now()
+	refresh := statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id)
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
+		Refresh: func() (any, string, error) {
+			output, status, err := refresh()
+
+			if err == nil && output != nil {
+				tflog.Info(ctx, "EKS Node Group update in progress", map[string]any{
+					"cluster_name":    clusterName,
+					"node_group_name": nodeGroupName,
+					"update_id":       id,
+					"update_type":     output.(*types.Update).Type,
+					"status":          status,
+					"elapsed":         time.Since(start).Round(time.Second).String(),
+				})
+			}
+
+			return output, status, err
+		},
 		Timeout: timeout,
 	}
 
@@ -941,6 +990,10 @@ func expandNodegroupUpdateConfig(tfMap map[string]any) *types.NodegroupUpdateCon
 		apiObject.MaxUnavailablePercentage = aws.Int32(int32(v))
 	}
 
+	if v, ok := tfMap["update_strategy"].(string); ok && v != "" {
+		apiObject.UpdateStrategy = types.NodegroupUpdateStrategies(v)
+	}
+
 	return apiObject
 }
 
@@ -955,6 +1008,22 @@ func expandNodeRepairConfig(tfMap map[string]any) *types.NodeRepairConfig {
 		apiObject.Enabled = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["max_parallel_nodes_repaired_count"].(int); ok && v != 0 {
+		apiObject.MaxParallelNodesRepairedCount = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["max_parallel_nodes_repaired_percentage"].(int); ok && v != 0 {
+		apiObject.MaxParallelNodesRepairedPercentage = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["max_unhealthy_node_threshold_count"].(int); ok && v != 0 {
+		apiObject.MaxUnhealthyNodeThresholdCount = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["max_unhealthy_node_threshold_percentage"].(int); ok && v != 0 {
+		apiObject.MaxUnhealthyNodeThresholdPercentage = aws.Int32(int32(v))
+	}
+
 	return apiObject
 }
 
@@ -1069,6 +1138,22 @@ func flattenNodeRepairConfig(apiObject *types.NodeRepairConfig) map[string]any {
 		tfMap[names.AttrEnabled] = aws.ToBool(v)
 	}
 
+	if v := apiObject.MaxParallelNodesRepairedCount; v != nil {
+		tfMap["max_parallel_nodes_repaired_count"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaxParallelNodesRepairedPercentage; v != nil {
+		tfMap["max_parallel_nodes_repaired_percentage"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaxUnhealthyNodeThresholdCount; v != nil {
+		tfMap["max_unhealthy_node_threshold_count"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaxUnhealthyNodeThresholdPercentage; v != nil {
+		tfMap["max_unhealthy_node_threshold_percentage"] = aws.ToInt32(v)
+	}
+
 	return tfMap
 }
 
@@ -1087,6 +1172,8 @@ func flattenNodegroupUpdateConfig(apiObject *types.NodegroupUpdateConfig) map[st
 		tfMap["max_unavailable_percentage"] = aws.ToInt32(v)
 	}
 
+	tfMap["update_strategy"] = apiObject.UpdateStrategy
+
 	return tfMap
 }
 
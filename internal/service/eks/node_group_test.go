@@ -531,6 +531,30 @@ func TestAccEKSNodeGroup_nodeRepairConfig(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccNodeGroupConfig_nodeRepairConfigThresholds(rName, "max_parallel_nodes_repaired_count", 1, "max_unhealthy_node_threshold_percentage", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup1),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_parallel_nodes_repaired_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_parallel_nodes_repaired_percentage", "0"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_unhealthy_node_threshold_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_unhealthy_node_threshold_percentage", "50"),
+				),
+			},
+			{
+				Config: testAccNodeGroupConfig_nodeRepairConfigThresholds(rName, "max_parallel_nodes_repaired_percentage", 20, "max_unhealthy_node_threshold_count", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup1),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_parallel_nodes_repaired_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_parallel_nodes_repaired_percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_unhealthy_node_threshold_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.max_unhealthy_node_threshold_percentage", "0"),
+				),
+			},
 		},
 	})
 }
@@ -949,6 +973,7 @@ func TestAccEKSNodeGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "update_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable", "1"),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable_percentage", "0"),
+					resource.TestCheckResourceAttr(resourceName, "update_config.0.update_strategy", "DEFAULT"),
 				),
 			},
 			{
@@ -963,6 +988,7 @@ func TestAccEKSNodeGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "update_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable", "0"),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable_percentage", "40"),
+					resource.TestCheckResourceAttr(resourceName, "update_config.0.update_strategy", "MINIMAL"),
 				),
 			},
 		},
@@ -2145,6 +2171,36 @@ resource "aws_eks_node_group" "test" {
 `, rName))
 }
 
+func testAccNodeGroupConfig_nodeRepairConfigThresholds(rName, parallelKey string, parallelValue int, thresholdKey string, thresholdValue int) string {
+	return acctest.ConfigCompose(testAccNodeGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 3
+    min_size     = 1
+  }
+
+  node_repair_config {
+    enabled = true
+    %[2]s   = %[3]d
+    %[4]s   = %[5]d
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodeMinimalPolicy,
+  ]
+}
+`, rName, parallelKey, parallelValue, thresholdKey, thresholdValue))
+}
+
 func testAccNodeGroupConfig_update1(rName string) string {
 	return acctest.ConfigCompose(testAccNodeGroupConfig_base(rName), fmt.Sprintf(`
 resource "aws_eks_node_group" "test" {
@@ -2189,6 +2245,7 @@ resource "aws_eks_node_group" "test" {
 
   update_config {
     max_unavailable_percentage = 40
+    update_strategy            = "MINIMAL"
   }
 
   depends_on = [
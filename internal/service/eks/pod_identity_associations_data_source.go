@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_eks_pod_identity_associations", name="Pod Identity Associations")
+func newPodIdentityAssociationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &podIdentityAssociationsDataSource{}, nil
+}
+
+type podIdentityAssociationsDataSource struct {
+	framework.DataSourceWithModel[podIdentityAssociationsDataSourceModel]
+}
+
+func (d *podIdentityAssociationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associations": framework.DataSourceComputedListOfObjectAttribute[podIdentityAssociationSummaryModel](ctx),
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				Optional: true,
+			},
+			"service_account": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *podIdentityAssociationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data podIdentityAssociationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EKSClient(ctx)
+
+	var input eks.ListPodIdentityAssociationsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findPodIdentityAssociationSummaries(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing EKS Pod Identity Associations (%s)", data.ClusterName.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Associations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findPodIdentityAssociationSummaries(ctx context.Context, conn *eks.Client, input *eks.ListPodIdentityAssociationsInput) ([]awstypes.PodIdentityAssociationSummary, error) {
+	output := make([]awstypes.PodIdentityAssociationSummary, 0)
+
+	pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Associations...)
+	}
+
+	return output, nil
+}
+
+type podIdentityAssociationsDataSourceModel struct {
+	framework.WithRegionModel
+	Associations   fwtypes.ListNestedObjectValueOf[podIdentityAssociationSummaryModel] `tfsdk:"associations"`
+	ClusterName    types.String                                                        `tfsdk:"cluster_name"`
+	Namespace      types.String                                                        `tfsdk:"namespace"`
+	ServiceAccount types.String                                                        `tfsdk:"service_account"`
+}
+
+type podIdentityAssociationSummaryModel struct {
+	AssociationARN types.String `tfsdk:"association_arn"`
+	AssociationID  types.String `tfsdk:"association_id"`
+	ClusterName    types.String `tfsdk:"cluster_name"`
+	Namespace      types.String `tfsdk:"namespace"`
+	OwnerARN       types.String `tfsdk:"owner_arn"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSPodIdentityAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_pod_identity_associations.test"
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "associations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.association_arn", resourceName, "association_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.association_id", resourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.cluster_name", resourceName, names.AttrClusterName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.namespace", resourceName, names.AttrNamespace),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associations.0.service_account", resourceName, "service_account"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPodIdentityAssociationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_basic(rName), fmt.Sprintf(`
+data "aws_eks_pod_identity_associations" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  namespace    = %[1]q
+
+  depends_on = [aws_eks_pod_identity_association.test]
+}
+`, rName))
+}
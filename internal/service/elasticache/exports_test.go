@@ -5,15 +5,16 @@ package elasticache
 
 // Exports for use in tests only.
 var (
-	ResourceCluster                = resourceCluster
-	ResourceGlobalReplicationGroup = resourceGlobalReplicationGroup
-	ResourceParameterGroup         = resourceParameterGroup
-	ResourceReplicationGroup       = resourceReplicationGroup
-	ResourceServerlessCache        = newServerlessCacheResource
-	ResourceSubnetGroup            = resourceSubnetGroup
-	ResourceUser                   = resourceUser
-	ResourceUserGroup              = resourceUserGroup
-	ResourceUserGroupAssociation   = resourceUserGroupAssociation
+	ResourceCluster                 = resourceCluster
+	ResourceGlobalReplicationGroup  = resourceGlobalReplicationGroup
+	ResourceParameterGroup          = resourceParameterGroup
+	ResourceReplicationGroup        = resourceReplicationGroup
+	ResourceServerlessCache         = newServerlessCacheResource
+	ResourceServerlessCacheSnapshot = newServerlessCacheSnapshotResource
+	ResourceSubnetGroup             = resourceSubnetGroup
+	ResourceUser                    = resourceUser
+	ResourceUserGroup               = resourceUserGroup
+	ResourceUserGroupAssociation    = resourceUserGroupAssociation
 
 	FindCacheClusterByID                 = findCacheClusterByID
 	FindCacheParameterGroup              = findCacheParameterGroup
@@ -23,6 +24,7 @@ var (
 	FindReplicationGroupByID             = findReplicationGroupByID
 	FindReservedCacheNodeByID            = findReservedCacheNodeByID
 	FindServerlessCacheByID              = findServerlessCacheByID
+	FindServerlessCacheSnapshotByID      = findServerlessCacheSnapshotByID
 	FindUserByID                         = findUserByID
 	FindUserGroupByID                    = findUserGroupByID
 	FindUserGroupAssociationByTwoPartKey = findUserGroupAssociationByTwoPartKey
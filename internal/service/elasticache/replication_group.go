@@ -1350,6 +1350,7 @@ const (
 	replicationGroupStatusCreateFailed = "create-failed"
 	replicationGroupStatusCreating     = "creating"
 	replicationGroupStatusDeleting     = "deleting"
+	replicationGroupStatusMigrating    = "migrating"
 	replicationGroupStatusModifying    = "modifying"
 	replicationGroupStatusSnapshotting = "snapshotting"
 )
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_elasticache_replication_group_migration", name="Replication Group Migration")
+func newReplicationGroupMigrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &replicationGroupMigrationResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type replicationGroupMigrationResource struct {
+	framework.ResourceWithModel[replicationGroupMigrationResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *replicationGroupMigrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"complete_migration": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"force_complete": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"replication_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"customer_node_endpoint": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customerNodeEndpointModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAddress: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrPort: schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *replicationGroupMigrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data replicationGroupMigrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	replicationGroupID := data.ReplicationGroupID.ValueString()
+	input := elasticache.StartMigrationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.StartMigration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting ElastiCache Replication Group (%s) migration", replicationGroupID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	output, err := waitReplicationGroupMigrating(ctx, conn, replicationGroupID, timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ElastiCache Replication Group (%s) migration start", replicationGroupID), err.Error())
+
+		return
+	}
+
+	if data.CompleteMigration.ValueBool() {
+		output, err = completeReplicationGroupMigration(ctx, conn, replicationGroupID, data.ForceComplete.ValueBool(), timeout)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("completing ElastiCache Replication Group (%s) migration", replicationGroupID), err.Error())
+
+			return
+		}
+	}
+
+	data.Status = fwflex.StringToFramework(ctx, output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *replicationGroupMigrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data replicationGroupMigrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	replicationGroupID := data.ReplicationGroupID.ValueString()
+	output, err := findReplicationGroupByID(ctx, conn, replicationGroupID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Replication Group (%s)", replicationGroupID), err.Error())
+
+		return
+	}
+
+	data.Status = fwflex.StringToFramework(ctx, output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *replicationGroupMigrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old replicationGroupMigrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	// A completed migration cannot be restarted, so only the transition to complete_migration = true is acted upon.
+	if new.CompleteMigration.ValueBool() && !old.CompleteMigration.ValueBool() {
+		replicationGroupID := new.ReplicationGroupID.ValueString()
+		output, err := completeReplicationGroupMigration(ctx, conn, replicationGroupID, new.ForceComplete.ValueBool(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("completing ElastiCache Replication Group (%s) migration", replicationGroupID), err.Error())
+
+			return
+		}
+
+		new.Status = fwflex.StringToFramework(ctx, output.Status)
+	} else {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *replicationGroupMigrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data replicationGroupMigrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ElastiCacheClient(ctx)
+
+	replicationGroupID := data.ReplicationGroupID.ValueString()
+	output, err := findReplicationGroupByID(ctx, conn, replicationGroupID)
+
+	if retry.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Replication Group (%s)", replicationGroupID), err.Error())
+
+		return
+	}
+
+	// Nothing to do if the migration has already been completed.
+	if aws.ToString(output.Status) != replicationGroupStatusMigrating {
+		return
+	}
+
+	tflog.Debug(ctx, "stopping ElastiCache Replication Group migration", map[string]any{
+		"replication_group_id": replicationGroupID,
+	})
+
+	// Forcing completion stops the migration without waiting for the data to be in sync.
+	if _, err := completeReplicationGroupMigration(ctx, conn, replicationGroupID, true, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping ElastiCache Replication Group (%s) migration", replicationGroupID), err.Error())
+
+		return
+	}
+}
+
+func completeReplicationGroupMigration(ctx context.Context, conn *elasticache.Client, replicationGroupID string, force bool, timeout time.Duration) (*awstypes.ReplicationGroup, error) {
+	input := elasticache.CompleteMigrationInput{
+		Force:              aws.Bool(force),
+		ReplicationGroupId: aws.String(replicationGroupID),
+	}
+
+	_, err := conn.CompleteMigration(ctx, &input)
+
+	if err != nil && !errs.IsA[*awstypes.ReplicationGroupNotUnderMigrationFault](err) {
+		return nil, err
+	}
+
+	return waitReplicationGroupMigrationCompleted(ctx, conn, replicationGroupID, timeout)
+}
+
+func waitReplicationGroupMigrating(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration) (*awstypes.ReplicationGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationGroupStatusAvailable,
+			replicationGroupStatusModifying,
+		},
+		Target:     []string{replicationGroupStatusMigrating},
+		Refresh:    statusReplicationGroup(conn, replicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationGroupMigrationCompleted(ctx context.Context, conn *elasticache.Client, replicationGroupID string, timeout time.Duration) (*awstypes.ReplicationGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationGroupStatusMigrating,
+			replicationGroupStatusModifying,
+		},
+		Target:     []string{replicationGroupStatusAvailable},
+		Refresh:    statusReplicationGroup(conn, replicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type replicationGroupMigrationResourceModel struct {
+	framework.WithRegionModel
+	CompleteMigration        types.Bool                                                 `tfsdk:"complete_migration"`
+	CustomerNodeEndpointList fwtypes.ListNestedObjectValueOf[customerNodeEndpointModel] `tfsdk:"customer_node_endpoint"`
+	ForceComplete            types.Bool                                                 `tfsdk:"force_complete"`
+	ID                       types.String                                               `tfsdk:"id"`
+	ReplicationGroupID       types.String                                               `tfsdk:"replication_group_id"`
+	Status                   types.String                                               `tfsdk:"status"`
+	Timeouts                 timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+func (data *replicationGroupMigrationResourceModel) setID() {
+	data.ID = data.ReplicationGroupID
+}
+
+type customerNodeEndpointModel struct {
+	Address types.String `tfsdk:"address"`
+	Port    types.Int64  `tfsdk:"port"`
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The source Redis node must be reachable from the default VPC, with the
+// migration prerequisites described in the ElastiCache User Guide in place.
+func TestAccElastiCacheReplicationGroupMigration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	sourceAddress := acctest.SkipIfEnvVarNotSet(t, "ELASTICACHE_MIGRATION_SOURCE_ADDRESS")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_replication_group_migration.test"
+	replicationGroupResourceName := "aws_elasticache_replication_group.test"
+	var rg awstypes.ReplicationGroup
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationGroupMigrationConfig_basic(rName, sourceAddress, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationGroupExists(ctx, t, replicationGroupResourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "complete_migration", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "customer_node_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "customer_node_endpoint.0.address", sourceAddress),
+					resource.TestCheckResourceAttr(resourceName, "customer_node_endpoint.0.port", "6379"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, replicationGroupResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "replication_group_id", replicationGroupResourceName, "replication_group_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "migrating"),
+				),
+			},
+			{
+				Config: testAccReplicationGroupMigrationConfig_basic(rName, sourceAddress, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "complete_migration", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationGroupMigrationConfig_basic(rName, sourceAddress string, complete bool) string {
+	return acctest.ConfigCompose(testAccReplicationGroupConfig_basic_engine(rName, "redis"), fmt.Sprintf(`
+resource "aws_elasticache_replication_group_migration" "test" {
+  replication_group_id = aws_elasticache_replication_group.test.id
+  complete_migration   = %[2]t
+
+  customer_node_endpoint {
+    address = %[1]q
+    port    = 6379
+  }
+}
+`, sourceAddress, complete))
+}
@@ -36,6 +36,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newReplicationGroupMigrationResource,
+			TypeName: "aws_elasticache_replication_group_migration",
+			Name:     "Replication Group Migration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newReservedCacheNodeResource,
 			TypeName: "aws_elasticache_reserved_cache_node",
@@ -54,6 +60,15 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newServerlessCacheSnapshotResource,
+			TypeName: "aws_elasticache_serverless_cache_snapshot",
+			Name:     "Serverless Cache Snapshot",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -5,9 +5,13 @@ package emrcontainers
 
 // Exports for use in tests only.
 var (
-	ResourceJobTemplate    = resourceJobTemplate
-	ResourceVirtualCluster = resourceVirtualCluster
+	ResourceJobTemplate           = resourceJobTemplate
+	ResourceManagedEndpoint       = newManagedEndpointResource
+	ResourceSecurityConfiguration = newSecurityConfigurationResource
+	ResourceVirtualCluster        = resourceVirtualCluster
 
-	FindJobTemplateByID    = findJobTemplateByID
-	FindVirtualClusterByID = findVirtualClusterByID
+	FindJobTemplateByID             = findJobTemplateByID
+	FindManagedEndpointByTwoPartKey = findManagedEndpointByTwoPartKey
+	FindSecurityConfigurationByID   = findSecurityConfigurationByID
+	FindVirtualClusterByID          = findVirtualClusterByID
 )
@@ -0,0 +1,563 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrcontainers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrcontainers"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emrcontainers/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	managedEndpointTypeJupyterEnterpriseGateway = "JUPYTER_ENTERPRISE_GATEWAY"
+)
+
+// @FrameworkResource("aws_emrcontainers_managed_endpoint", name="Managed Endpoint")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newManagedEndpointResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &managedEndpointResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type managedEndpointResource struct {
+	framework.ResourceWithModel[managedEndpointResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *managedEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"release_label": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EndpointState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrSubnetIDs: schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(managedEndpointTypeJupyterEnterpriseGateway),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration_overrides": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configurationOverridesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"application_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[applicationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(100),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"classification": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrProperties: schema.MapAttribute{
+										CustomType: fwtypes.MapOfStringType,
+										Optional:   true,
+										PlanModifiers: []planmodifier.Map{
+											mapplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"configurations": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[configurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(100),
+										},
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"classification": schema.StringAttribute{
+													Required: true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.RequiresReplace(),
+													},
+												},
+												names.AttrProperties: schema.MapAttribute{
+													CustomType: fwtypes.MapOfStringType,
+													Optional:   true,
+													PlanModifiers: []planmodifier.Map{
+														mapplanmodifier.RequiresReplace(),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"monitoring_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[monitoringConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"persistent_app_ui": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.PersistentAppUI](),
+										Optional:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"cloud_watch_monitoring_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[cloudWatchMonitoringConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrLogGroupName: schema.StringAttribute{
+													Required: true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.RequiresReplace(),
+													},
+												},
+												"log_stream_name_prefix": schema.StringAttribute{
+													Optional: true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.RequiresReplace(),
+													},
+												},
+											},
+										},
+									},
+									"s3_monitoring_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3MonitoringConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"log_uri": schema.StringAttribute{
+													Required: true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.RequiresReplace(),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *managedEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data managedEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EMRContainersClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input emrcontainers.CreateManagedEndpointInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateManagedEndpoint(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EMR Containers Managed Endpoint (%s)", name), err.Error())
+
+		return
+	}
+
+	id, virtualClusterID := aws.ToString(output.Id), aws.ToString(output.VirtualClusterId)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	endpoint, err := waitManagedEndpointCreated(ctx, conn, virtualClusterID, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EMR Containers Managed Endpoint (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, endpoint.Arn)
+	data.SecurityGroup = fwflex.StringToFramework(ctx, endpoint.SecurityGroup)
+	data.ServerURL = fwflex.StringToFramework(ctx, endpoint.ServerUrl)
+	data.State = fwtypes.StringEnumValue(endpoint.State)
+	data.SubnetIDs = fwflex.FlattenFrameworkStringValueListOfString(ctx, endpoint.SubnetIds)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *managedEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data managedEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EMRContainersClient(ctx)
+
+	id, virtualClusterID := fwflex.StringValueFromFramework(ctx, data.ID), fwflex.StringValueFromFramework(ctx, data.VirtualClusterID)
+	endpoint, err := findManagedEndpointByTwoPartKey(ctx, conn, virtualClusterID, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EMR Containers Managed Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, endpoint, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, endpoint.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *managedEndpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new managedEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *managedEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data managedEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EMRContainersClient(ctx)
+
+	id, virtualClusterID := fwflex.StringValueFromFramework(ctx, data.ID), fwflex.StringValueFromFramework(ctx, data.VirtualClusterID)
+	input := emrcontainers.DeleteManagedEndpointInput{
+		Id:               aws.String(id),
+		VirtualClusterId: aws.String(virtualClusterID),
+	}
+	_, err := conn.DeleteManagedEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EMR Containers Managed Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitManagedEndpointDeleted(ctx, conn, virtualClusterID, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EMR Containers Managed Endpoint (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *managedEndpointResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	const (
+		managedEndpointIDParts = 2
+	)
+	parts, err := intflex.ExpandResourceId(request.ID, managedEndpointIDParts, false)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("virtual_cluster_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func findManagedEndpoint(ctx context.Context, conn *emrcontainers.Client, input *emrcontainers.DescribeManagedEndpointInput) (*awstypes.Endpoint, error) {
+	output, err := conn.DescribeManagedEndpoint(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Endpoint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Endpoint, nil
+}
+
+func findManagedEndpointByTwoPartKey(ctx context.Context, conn *emrcontainers.Client, virtualClusterID, id string) (*awstypes.Endpoint, error) {
+	input := emrcontainers.DescribeManagedEndpointInput{
+		Id:               aws.String(id),
+		VirtualClusterId: aws.String(virtualClusterID),
+	}
+
+	output, err := findManagedEndpoint(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.EndpointStateTerminated {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusManagedEndpoint(ctx context.Context, conn *emrcontainers.Client, virtualClusterID, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findManagedEndpointByTwoPartKey(ctx, conn, virtualClusterID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitManagedEndpointCreated(ctx context.Context, conn *emrcontainers.Client, virtualClusterID, id string, timeout time.Duration) (*awstypes.Endpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EndpointStateCreating),
+		Target:  enum.Slice(awstypes.EndpointStateActive),
+		Refresh: statusManagedEndpoint(ctx, conn, virtualClusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Endpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitManagedEndpointDeleted(ctx context.Context, conn *emrcontainers.Client, virtualClusterID, id string, timeout time.Duration) (*awstypes.Endpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EndpointStateActive, awstypes.EndpointStateTerminating),
+		Target:  []string{},
+		Refresh: statusManagedEndpoint(ctx, conn, virtualClusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Endpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type managedEndpointResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                                 `tfsdk:"arn"`
+	ConfigurationOverrides fwtypes.ListNestedObjectValueOf[configurationOverridesModel] `tfsdk:"configuration_overrides"`
+	ExecutionRoleARN       fwtypes.ARN                                                  `tfsdk:"execution_role_arn"`
+	ID                     types.String                                                 `tfsdk:"id"`
+	Name                   types.String                                                 `tfsdk:"name"`
+	ReleaseLabel           types.String                                                 `tfsdk:"release_label"`
+	SecurityGroup          types.String                                                 `tfsdk:"security_group"`
+	ServerURL              types.String                                                 `tfsdk:"server_url"`
+	State                  fwtypes.StringEnum[awstypes.EndpointState]                   `tfsdk:"state"`
+	SubnetIDs              fwtypes.ListOfString                                         `tfsdk:"subnet_ids"`
+	Tags                   tftags.Map                                                   `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                   `tfsdk:"tags_all"`
+	Timeouts               timeouts.Value                                               `tfsdk:"timeouts"`
+	Type                   types.String                                                 `tfsdk:"type"`
+	VirtualClusterID       types.String                                                 `tfsdk:"virtual_cluster_id"`
+}
+
+type configurationOverridesModel struct {
+	ApplicationConfiguration fwtypes.ListNestedObjectValueOf[applicationConfigurationModel] `tfsdk:"application_configuration"`
+	MonitoringConfiguration  fwtypes.ListNestedObjectValueOf[monitoringConfigurationModel]  `tfsdk:"monitoring_configuration"`
+}
+
+type applicationConfigurationModel struct {
+	Classification types.String                                        `tfsdk:"classification"`
+	Configurations fwtypes.ListNestedObjectValueOf[configurationModel] `tfsdk:"configurations"`
+	Properties     fwtypes.MapOfString                                 `tfsdk:"properties"`
+}
+
+type configurationModel struct {
+	Classification types.String        `tfsdk:"classification"`
+	Properties     fwtypes.MapOfString `tfsdk:"properties"`
+}
+
+type monitoringConfigurationModel struct {
+	CloudWatchMonitoringConfiguration fwtypes.ListNestedObjectValueOf[cloudWatchMonitoringConfigurationModel] `tfsdk:"cloud_watch_monitoring_configuration"`
+	PersistentAppUI                   fwtypes.StringEnum[awstypes.PersistentAppUI]                            `tfsdk:"persistent_app_ui"`
+	S3MonitoringConfiguration         fwtypes.ListNestedObjectValueOf[s3MonitoringConfigurationModel]         `tfsdk:"s3_monitoring_configuration"`
+}
+
+type cloudWatchMonitoringConfigurationModel struct {
+	LogGroupName        types.String `tfsdk:"log_group_name"`
+	LogStreamNamePrefix types.String `tfsdk:"log_stream_name_prefix"`
+}
+
+type s3MonitoringConfigurationModel struct {
+	LogURI types.String `tfsdk:"log_uri"`
+}
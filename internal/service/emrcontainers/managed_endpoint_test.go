@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emrcontainers/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRContainersManagedEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Endpoint
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_managed_endpoint.test"
+	testExternalProviders := map[string]resource.ExternalProvider{
+		"kubernetes": {
+			Source:            "hashicorp/kubernetes",
+			VersionConstraint: "~> 2.3",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckIAMServiceLinkedRole(ctx, t, "/aws-service-role/emr-containers.amazonaws.com")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRContainersServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders:        testExternalProviders,
+		CheckDestroy:             testAccCheckManagedEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "release_label", "emr-6.10.0-latest"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "JUPYTER_ENTERPRISE_GATEWAY"),
+					resource.TestCheckResourceAttrPair(resourceName, "virtual_cluster_id", "aws_emrcontainers_virtual_cluster.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			// Import is not tested as the kubernetes provider configuration
+			// depends on values that cannot be determined until apply.
+		},
+	})
+}
+
+func TestAccEMRContainersManagedEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Endpoint
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_managed_endpoint.test"
+	testExternalProviders := map[string]resource.ExternalProvider{
+		"kubernetes": {
+			Source:            "hashicorp/kubernetes",
+			VersionConstraint: "~> 2.3",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckIAMServiceLinkedRole(ctx, t, "/aws-service-role/emr-containers.amazonaws.com")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRContainersServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders:        testExternalProviders,
+		CheckDestroy:             testAccCheckManagedEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedEndpointExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfemrcontainers.ResourceManagedEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckManagedEndpointExists(ctx context.Context, n string, v *awstypes.Endpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersClient(ctx)
+
+		output, err := tfemrcontainers.FindManagedEndpointByTwoPartKey(ctx, conn, rs.Primary.Attributes["virtual_cluster_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckManagedEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_emrcontainers_managed_endpoint" {
+				continue
+			}
+
+			_, err := tfemrcontainers.FindManagedEndpointByTwoPartKey(ctx, conn, rs.Primary.Attributes["virtual_cluster_id"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EMR Containers Managed Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccManagedEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVirtualClusterConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "%[1]s-execution"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticmapreduce.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_emrcontainers_managed_endpoint" "test" {
+  name               = %[1]q
+  virtual_cluster_id = aws_emrcontainers_virtual_cluster.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  release_label      = "emr-6.10.0-latest"
+}
+`, rName))
+}
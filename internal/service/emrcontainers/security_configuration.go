@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrcontainers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrcontainers"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emrcontainers/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_emrcontainers_security_configuration", name="Security Configuration")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newSecurityConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityConfigurationResource{}, nil
+}
+
+type securityConfigurationResource struct {
+	framework.ResourceWithModel[securityConfigurationResourceModel]
+	framework.WithImportByID
+}
+
+func (r *securityConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"security_configuration_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[securityConfigurationDataModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"authorization_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[authorizationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrEncryptionConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"in_transit_encryption_configuration": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[inTransitEncryptionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													PlanModifiers: []planmodifier.List{
+														listplanmodifier.RequiresReplace(),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"tls_certificate_configuration": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[tlsCertificateConfigurationModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																},
+																PlanModifiers: []planmodifier.List{
+																	listplanmodifier.RequiresReplace(),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"certificate_provider_type": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.CertificateProviderType](),
+																			Optional:   true,
+																			PlanModifiers: []planmodifier.String{
+																				stringplanmodifier.RequiresReplace(),
+																			},
+																		},
+																		"private_certificate_secret_arn": schema.StringAttribute{
+																			CustomType: fwtypes.ARNType,
+																			Optional:   true,
+																			PlanModifiers: []planmodifier.String{
+																				stringplanmodifier.RequiresReplace(),
+																			},
+																		},
+																		"public_certificate_secret_arn": schema.StringAttribute{
+																			CustomType: fwtypes.ARNType,
+																			Optional:   true,
+																			PlanModifiers: []planmodifier.String{
+																				stringplanmodifier.RequiresReplace(),
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"lake_formation_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[lakeFormationConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"authorized_session_tag_value": schema.StringAttribute{
+													Optional: true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.RequiresReplace(),
+													},
+												},
+												"query_engine_role_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Optional:   true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.RequiresReplace(),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"secure_namespace_info": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[secureNamespaceInfoModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													PlanModifiers: []planmodifier.List{
+														listplanmodifier.RequiresReplace(),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"cluster_id": schema.StringAttribute{
+																Optional: true,
+																PlanModifiers: []planmodifier.String{
+																	stringplanmodifier.RequiresReplace(),
+																},
+															},
+															names.AttrNamespace: schema.StringAttribute{
+																Optional: true,
+																PlanModifiers: []planmodifier.String{
+																	stringplanmodifier.RequiresReplace(),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *securityConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EMRContainersClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input emrcontainers.CreateSecurityConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateSecurityConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EMR Containers Security Configuration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *securityConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EMRContainersClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findSecurityConfigurationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EMR Containers Security Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new securityConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data securityConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no API to delete an EMR Containers security configuration.
+	response.Diagnostics.AddWarning(
+		"Resource Destruction",
+		fmt.Sprintf("EMR Containers Security Configuration (%s) cannot be deleted. It has been removed from Terraform state only.", data.ID.ValueString()),
+	)
+}
+
+func findSecurityConfiguration(ctx context.Context, conn *emrcontainers.Client, input *emrcontainers.DescribeSecurityConfigurationInput) (*awstypes.SecurityConfiguration, error) {
+	output, err := conn.DescribeSecurityConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityConfiguration, nil
+}
+
+func findSecurityConfigurationByID(ctx context.Context, conn *emrcontainers.Client, id string) (*awstypes.SecurityConfiguration, error) {
+	input := emrcontainers.DescribeSecurityConfigurationInput{
+		Id: aws.String(id),
+	}
+
+	return findSecurityConfiguration(ctx, conn, &input)
+}
+
+type securityConfigurationResourceModel struct {
+	framework.WithRegionModel
+	ARN                       types.String                                                    `tfsdk:"arn"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	Name                      types.String                                                    `tfsdk:"name"`
+	SecurityConfigurationData fwtypes.ListNestedObjectValueOf[securityConfigurationDataModel] `tfsdk:"security_configuration_data"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
+}
+
+type securityConfigurationDataModel struct {
+	AuthorizationConfiguration fwtypes.ListNestedObjectValueOf[authorizationConfigurationModel] `tfsdk:"authorization_configuration"`
+}
+
+type authorizationConfigurationModel struct {
+	EncryptionConfiguration    fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel]    `tfsdk:"encryption_configuration"`
+	LakeFormationConfiguration fwtypes.ListNestedObjectValueOf[lakeFormationConfigurationModel] `tfsdk:"lake_formation_configuration"`
+}
+
+type encryptionConfigurationModel struct {
+	InTransitEncryptionConfiguration fwtypes.ListNestedObjectValueOf[inTransitEncryptionConfigurationModel] `tfsdk:"in_transit_encryption_configuration"`
+}
+
+type inTransitEncryptionConfigurationModel struct {
+	TLSCertificateConfiguration fwtypes.ListNestedObjectValueOf[tlsCertificateConfigurationModel] `tfsdk:"tls_certificate_configuration"`
+}
+
+type tlsCertificateConfigurationModel struct {
+	CertificateProviderType     fwtypes.StringEnum[awstypes.CertificateProviderType] `tfsdk:"certificate_provider_type"`
+	PrivateCertificateSecretARN fwtypes.ARN                                          `tfsdk:"private_certificate_secret_arn"`
+	PublicCertificateSecretARN  fwtypes.ARN                                          `tfsdk:"public_certificate_secret_arn"`
+}
+
+type lakeFormationConfigurationModel struct {
+	AuthorizedSessionTagValue types.String                                              `tfsdk:"authorized_session_tag_value"`
+	QueryEngineRoleARN        fwtypes.ARN                                               `tfsdk:"query_engine_role_arn"`
+	SecureNamespaceInfo       fwtypes.ListNestedObjectValueOf[secureNamespaceInfoModel] `tfsdk:"secure_namespace_info"`
+}
+
+type secureNamespaceInfoModel struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Namespace types.String `tfsdk:"namespace"`
+}
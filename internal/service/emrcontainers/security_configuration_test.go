@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emrcontainers/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRContainersSecurityConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_security_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRContainersServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Security configurations cannot be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.0.authorization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.0.authorization_configuration.0.lake_formation_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.0.authorization_configuration.0.lake_formation_configuration.0.authorized_session_tag_value", "EMR on EKS Engine"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_configuration_data.0.authorization_configuration.0.lake_formation_configuration.0.query_engine_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.0.authorization_configuration.0.lake_formation_configuration.0.secure_namespace_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.0.authorization_configuration.0.lake_formation_configuration.0.secure_namespace_info.0.namespace", "secure"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityConfigurationExists(ctx context.Context, n string, v *awstypes.SecurityConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersClient(ctx)
+
+		output, err := tfemrcontainers.FindSecurityConfigurationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSecurityConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticmapreduce.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_emrcontainers_security_configuration" "test" {
+  name = %[1]q
+
+  security_configuration_data {
+    authorization_configuration {
+      lake_formation_configuration {
+        authorized_session_tag_value = "EMR on EKS Engine"
+        query_engine_role_arn        = aws_iam_role.test.arn
+
+        secure_namespace_info {
+          cluster_id = "test"
+          namespace  = "secure"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -104,6 +104,11 @@ func resourceVirtualCluster() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_./#-]+`), "must contain only alphanumeric, hyphen, underscore, dot and # characters"),
 				),
 			},
+			"security_configuration_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -124,6 +129,10 @@ func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, m
 		input.ContainerProvider = expandContainerProvider(v.([]any)[0].(map[string]any))
 	}
 
+	if v, ok := d.GetOk("security_configuration_id"); ok {
+		input.SecurityConfigurationId = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateVirtualCluster(ctx, input)
 
 	if err != nil {
@@ -160,6 +169,7 @@ func resourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("container_provider", nil)
 	}
 	d.Set(names.AttrName, vc.Name)
+	d.Set("security_configuration_id", vc.SecurityConfigurationId)
 
 	setTagsOut(ctx, vc.Tags)
 
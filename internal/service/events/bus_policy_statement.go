@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudwatch_event_bus_policy_statement", name="Event Bus Policy Statement")
+func resourceBusPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBusPolicyStatementPut,
+		ReadWithoutTimeout:   resourceBusPolicyStatementRead,
+		UpdateWithoutTimeout: resourceBusPolicyStatementPut,
+		DeleteWithoutTimeout: resourceBusPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validBusName,
+				Default:      DefaultEventBusName,
+			},
+			"statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return tfiam.PolicyStatementsEquivalent(old, new)
+				},
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"statement_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens, and underscores"),
+				),
+			},
+		},
+	}
+}
+
+func resourceBusPolicyStatementPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	eventBusName, statementID := d.Get("event_bus_name").(string), d.Get("statement_id").(string)
+	id, err := flex.FlattenResourceId([]string{eventBusName, statementID}, busPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	err = modifyEventBusPolicy(ctx, conn, eventBusName, func(policy string) (string, error) {
+		return tfiam.PolicyPutStatement(policy, statementID, d.Get("statement").(string))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting EventBridge Event Bus Policy Statement (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceBusPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceBusPolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), busPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	eventBusName, statementID := parts[0], parts[1]
+	statement, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func(ctx context.Context) (string, error) {
+		return findBusPolicyStatementByTwoPartKey(ctx, conn, eventBusName, statementID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Event Bus Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Event Bus Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("event_bus_name", eventBusName)
+	d.Set("statement", tfiam.PolicyStatementToSet(d.Get("statement").(string), statement))
+	d.Set("statement_id", statementID)
+
+	return diags
+}
+
+func resourceBusPolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), busPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	eventBusName, statementID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting EventBridge Event Bus Policy Statement: %s", d.Id())
+	err = modifyEventBusPolicy(ctx, conn, eventBusName, func(policy string) (string, error) {
+		policy, n, err := tfiam.PolicyRemoveStatement(policy, statementID)
+		if err != nil {
+			return "", err
+		}
+
+		// Remove the event bus policy altogether once the last statement is gone.
+		if n == 0 {
+			return "", nil
+		}
+
+		return policy, nil
+	})
+
+	if tfresource.NotFound(err) || errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EventBridge Event Bus Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const (
+	busPolicyStatementResourceIDPartCount = 2
+)
+
+// modifyEventBusPolicy applies f to the event bus's current policy and writes back the result.
+// Modifications to the same event bus's policy are serialized.
+// An empty result removes the event bus policy.
+func modifyEventBusPolicy(ctx context.Context, conn *eventbridge.Client, eventBusName string, f func(string) (string, error)) error {
+	mutexKey := fmt.Sprintf("eventbridge-event-bus-policy-%s", eventBusName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findEventBusByName(ctx, conn, eventBusName)
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := f(aws.ToString(output.Policy))
+
+	if err != nil {
+		return err
+	}
+
+	if policy == "" {
+		input := eventbridge.RemovePermissionInput{
+			EventBusName:         aws.String(eventBusName),
+			RemoveAllPermissions: true,
+		}
+
+		_, err = conn.RemovePermission(ctx, &input)
+
+		return err
+	}
+
+	input := eventbridge.PutPermissionInput{
+		EventBusName: aws.String(eventBusName),
+		Policy:       aws.String(policy),
+	}
+
+	_, err = conn.PutPermission(ctx, &input)
+
+	return err
+}
+
+func findBusPolicyStatementByTwoPartKey(ctx context.Context, conn *eventbridge.Client, eventBusName, statementID string) (string, error) {
+	policy, err := findEventBusPolicyByName(ctx, conn, eventBusName)
+
+	if err != nil {
+		return "", err
+	}
+
+	return tfiam.PolicyFindStatement(aws.ToString(policy), statementID)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevents "github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsBusPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_event_bus_policy_statement.test"
+	resource2Name := "aws_cloudwatch_event_bus_policy_statement.test2"
+	busResourceName := "aws_cloudwatch_event_bus.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBusPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusPolicyStatementConfig_basic(rName, "events:PutEvents"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusPolicyStatementExists(ctx, resourceName),
+					testAccCheckBusPolicyStatementExists(ctx, resource2Name),
+					resource.TestCheckResourceAttrPair(resourceName, "event_bus_name", busResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "PutEvents"),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile("events:PutEvents")),
+					resource.TestCheckResourceAttr(resource2Name, "statement_id", "ManageRules"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBusPolicyStatementConfig_basic(rName, "events:DescribeRule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusPolicyStatementExists(ctx, resourceName),
+					testAccCheckBusPolicyStatementExists(ctx, resource2Name),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile("events:DescribeRule")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsBusPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_event_bus_policy_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBusPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusPolicyStatementConfig_basic(rName, "events:PutEvents"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBusPolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfevents.ResourceBusPolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBusPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_event_bus_policy_statement" {
+				continue
+			}
+
+			_, err := tfevents.FindBusPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["event_bus_name"], rs.Primary.Attributes["statement_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EventBridge Event Bus Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBusPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsClient(ctx)
+
+		_, err := tfevents.FindBusPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["event_bus_name"], rs.Primary.Attributes["statement_id"])
+
+		return err
+	}
+}
+
+func testAccBusPolicyStatementConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_bus_policy_statement" "test" {
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+  statement_id   = "PutEvents"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = %[2]q
+    Resource = aws_cloudwatch_event_bus.test.arn
+  })
+}
+
+resource "aws_cloudwatch_event_bus_policy_statement" "test2" {
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+  statement_id   = "ManageRules"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = ["events:PutRule", "events:DeleteRule"]
+    Resource = "arn:${data.aws_partition.current.partition}:events:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:rule/${aws_cloudwatch_event_bus.test.name}/*"
+  })
+}
+`, rName, action)
+}
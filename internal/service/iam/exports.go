@@ -9,7 +9,12 @@ var (
 
 	DeleteServiceLinkedRole     = deleteServiceLinkedRole
 	FindRoleByName              = findRoleByName
+	PolicyFindStatement         = policyFindStatement
 	PolicyHasValidAWSPrincipals = policyHasValidAWSPrincipals // nosemgrep:ci.aws-in-var-name
+	PolicyPutStatement          = policyPutStatement
+	PolicyRemoveStatement       = policyRemoveStatement
+	PolicyStatementToSet        = policyStatementToSet
+	PolicyStatementsEquivalent  = policyStatementsEquivalent
 )
 
 type (
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyStatementDefaultVersion = "2012-10-17"
+)
+
+// The helpers below operate on generic JSON rather than iamPolicyDoc so that
+// statements owned by other resources are written back exactly as read.
+
+// policyPutStatement adds the specified statement to a resource-based policy,
+// replacing any existing statement with the same Sid.
+// An empty policy is treated as a new policy document.
+func policyPutStatement(policy, sid, statement string) (string, error) {
+	doc, statements, err := decodePolicyStatements(policy)
+	if err != nil {
+		return "", err
+	}
+
+	var newStatement map[string]any
+	if err := json.Unmarshal([]byte(statement), &newStatement); err != nil {
+		return "", fmt.Errorf("parsing policy statement: %w", err)
+	}
+
+	if v, ok := newStatement["Sid"]; ok && v != sid {
+		return "", fmt.Errorf("policy statement Sid (%v) does not match statement ID (%s)", v, sid)
+	}
+	newStatement["Sid"] = sid
+
+	var seen bool
+	for i, v := range statements {
+		if policyStatementSid(v) == sid {
+			statements[i] = newStatement
+			seen = true
+			break
+		}
+	}
+	if !seen {
+		statements = append(statements, newStatement)
+	}
+
+	if _, ok := doc["Version"]; !ok {
+		doc["Version"] = policyStatementDefaultVersion
+	}
+	doc["Statement"] = statements
+
+	return encodePolicy(doc)
+}
+
+// policyRemoveStatement removes the statement with the specified Sid from a resource-based policy.
+// The number of statements remaining in the policy is also returned.
+func policyRemoveStatement(policy, sid string) (string, int, error) {
+	doc, statements, err := decodePolicyStatements(policy)
+	if err != nil {
+		return "", 0, err
+	}
+
+	statements = slices.DeleteFunc(statements, func(v any) bool {
+		return policyStatementSid(v) == sid
+	})
+	doc["Statement"] = statements
+
+	output, err := encodePolicy(doc)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return output, len(statements), nil
+}
+
+// policyFindStatement returns the statement with the specified Sid from a resource-based policy.
+// The Sid is removed from the returned statement.
+func policyFindStatement(policy, sid string) (string, error) {
+	_, statements, err := decodePolicyStatements(policy)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range statements {
+		if policyStatementSid(v) != sid {
+			continue
+		}
+
+		statement := v.(map[string]any)
+		delete(statement, "Sid")
+
+		output, err := json.Marshal(statement)
+		if err != nil {
+			return "", err
+		}
+
+		return string(output), nil
+	}
+
+	return "", &retry.NotFoundError{
+		Message: fmt.Sprintf("policy statement (%s) not found", sid),
+	}
+}
+
+// policyStatementsEquivalent returns whether two policy statements are semantically equivalent.
+func policyStatementsEquivalent(s1, s2 string) bool {
+	return verify.PolicyStringsEquivalent(policyStatementDocument(s1), policyStatementDocument(s2))
+}
+
+// policyStatementToSet returns the existing statement if the new statement is equivalent.
+// Otherwise, it returns the new statement.
+func policyStatementToSet(exist, new string) string {
+	if exist != "" && policyStatementsEquivalent(exist, new) {
+		return exist
+	}
+
+	return new
+}
+
+// policyStatementDocument wraps a policy statement in a policy document for comparison.
+// Any Sid is removed as it is managed separately from the statement.
+func policyStatementDocument(statement string) string {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(statement), &v); err == nil {
+		if _, ok := v["Sid"]; ok {
+			delete(v, "Sid")
+			if output, err := json.Marshal(v); err == nil {
+				statement = string(output)
+			}
+		}
+	}
+
+	return fmt.Sprintf(`{"Version":%q,"Statement":[%s]}`, policyStatementDefaultVersion, statement)
+}
+
+func policyStatementSid(v any) string {
+	if m, ok := v.(map[string]any); ok {
+		if sid, ok := m["Sid"].(string); ok {
+			return sid
+		}
+	}
+
+	return ""
+}
+
+func decodePolicyStatements(policy string) (map[string]any, []any, error) {
+	doc := make(map[string]any)
+
+	if policy != "" {
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return nil, nil, fmt.Errorf("parsing policy: %w", err)
+		}
+	}
+
+	var statements []any
+	switch v := doc["Statement"].(type) {
+	case nil:
+	case []any:
+		statements = v
+	case map[string]any:
+		statements = []any{v}
+	default:
+		return nil, nil, fmt.Errorf("unsupported data type %T for policy Statement", v)
+	}
+
+	return doc, statements, nil
+}
+
+func encodePolicy(doc map[string]any) (string, error) {
+	output, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(string(output))
+}
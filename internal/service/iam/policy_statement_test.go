@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestPolicyPutStatement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy    string
+		sid       string
+		statement string
+		expected  string
+		wantErr   bool
+	}{
+		"empty policy": {
+			sid:       "Test",
+			statement: `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Sid":"Test","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+		},
+		"append": {
+			policy:    `{"Version":"2012-10-17","Id":"Policy","Statement":[{"Sid":"Other","Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sqs:*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":3600}}}]}`,
+			sid:       "Test",
+			statement: `{"Effect":"Deny","Principal":"*","Action":"sqs:DeleteQueue","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Id":"Policy","Statement":[{"Sid":"Other","Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sqs:*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":3600}}},{"Sid":"Test","Effect":"Deny","Principal":"*","Action":"sqs:DeleteQueue","Resource":"*"}]}`,
+		},
+		"replace": {
+			policy:    `{"Version":"2012-10-17","Statement":[{"Sid":"Test","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"},{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`,
+			sid:       "Test",
+			statement: `{"Sid":"Test","Effect":"Deny","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			expected:  `{"Version":"2012-10-17","Statement":[{"Sid":"Test","Effect":"Deny","Principal":"*","Action":"sqs:SendMessage","Resource":"*"},{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`,
+		},
+		"single statement object": {
+			policy:    `{"Version":"2008-10-17","Statement":{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"SNS:Publish","Resource":"*"}}`,
+			sid:       "Test",
+			statement: `{"Effect":"Allow","Principal":"*","Action":"SNS:Subscribe","Resource":"*"}`,
+			expected:  `{"Version":"2008-10-17","Statement":[{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"SNS:Publish","Resource":"*"},{"Sid":"Test","Effect":"Allow","Principal":"*","Action":"SNS:Subscribe","Resource":"*"}]}`,
+		},
+		"mismatched Sid": {
+			sid:       "Test",
+			statement: `{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			wantErr:   true,
+		},
+		"invalid policy": {
+			policy:    `{`,
+			sid:       "Test",
+			statement: `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfiam.PolicyPutStatement(testCase.policy, testCase.sid, testCase.statement)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("PolicyPutStatement() err %t, want %t (%v)", got, want, err)
+			}
+
+			if err == nil && !verify.JSONStringsEqual(got, testCase.expected) {
+				t.Errorf("PolicyPutStatement() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPolicyRemoveStatement(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"Test","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"},{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`
+
+	got, n, err := tfiam.PolicyRemoveStatement(policy, "Test")
+	if err != nil {
+		t.Fatalf("PolicyRemoveStatement() unexpected error: %s", err)
+	}
+
+	if want := `{"Version":"2012-10-17","Statement":[{"Sid":"Other","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`; !verify.JSONStringsEqual(got, want) {
+		t.Errorf("PolicyRemoveStatement() = %s, want %s", got, want)
+	}
+	if n != 1 {
+		t.Errorf("PolicyRemoveStatement() remaining = %d, want 1", n)
+	}
+
+	_, n, err = tfiam.PolicyRemoveStatement(got, "Other")
+	if err != nil {
+		t.Fatalf("PolicyRemoveStatement() unexpected error: %s", err)
+	}
+	if n != 0 {
+		t.Errorf("PolicyRemoveStatement() remaining = %d, want 0", n)
+	}
+}
+
+func TestPolicyFindStatement(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"Test","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`
+
+	got, err := tfiam.PolicyFindStatement(policy, "Test")
+	if err != nil {
+		t.Fatalf("PolicyFindStatement() unexpected error: %s", err)
+	}
+
+	if want := `{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`; !verify.JSONStringsEqual(got, want) {
+		t.Errorf("PolicyFindStatement() = %s, want %s", got, want)
+	}
+
+	_, err = tfiam.PolicyFindStatement(policy, "Other")
+	if !tfresource.NotFound(err) {
+		t.Errorf("PolicyFindStatement() err = %v, want NotFound", err)
+	}
+}
+
+func TestPolicyStatementsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	s1 := `{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":["sqs:SendMessage"],"Resource":"*"}` // lintignore:AWSAT005
+	s2 := `{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":"*"}`     // lintignore:AWSAT005
+
+	if !tfiam.PolicyStatementsEquivalent(s1, s2) {
+		t.Errorf("PolicyStatementsEquivalent() = false, want true")
+	}
+
+	s3 := `{"Effect":"Deny","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}`
+
+	if tfiam.PolicyStatementsEquivalent(s1, s3) {
+		t.Errorf("PolicyStatementsEquivalent() = true, want false")
+	}
+
+	// The Sid is managed separately from the statement.
+	s4 := `{"Sid":"Test","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sqs:SendMessage","Resource":"*"}` // lintignore:AWSAT005
+
+	if !tfiam.PolicyStatementsEquivalent(s4, s2) {
+		t.Errorf("PolicyStatementsEquivalent() = false, want true")
+	}
+}
@@ -135,6 +135,21 @@ func resourceApplication() *schema.Resource {
 								},
 								ConflictsWith: []string{"application_configuration.0.sql_application_configuration"},
 							},
+							"application_system_rollback_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"rollback_enabled": {
+											Type:     schema.TypeBool,
+											Required: true,
+										},
+									},
+								},
+								ConflictsWith: []string{"application_configuration.0.sql_application_configuration"},
+							},
 							"environment_properties": {
 								Type:     schema.TypeList,
 								Optional: true,
@@ -746,6 +761,7 @@ func resourceApplication() *schema.Resource {
 								},
 								ConflictsWith: []string{
 									"application_configuration.0.application_snapshot_configuration",
+									"application_configuration.0.application_system_rollback_configuration",
 									"application_configuration.0.environment_properties",
 									"application_configuration.0.flink_application_configuration",
 									"application_configuration.0.run_configuration",
@@ -976,6 +992,12 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 				updateApplication = true
 			}
 
+			if d.HasChange("application_configuration.0.application_system_rollback_configuration") {
+				applicationConfigurationUpdate.ApplicationSystemRollbackConfigurationUpdate = expandApplicationSystemRollbackConfigurationUpdate(d.Get("application_configuration.0.application_system_rollback_configuration").([]any))
+
+				updateApplication = true
+			}
+
 			if d.HasChange("application_configuration.0.environment_properties") {
 				applicationConfigurationUpdate.EnvironmentPropertyUpdates = expandEnvironmentPropertyUpdates(d.Get("application_configuration.0.environment_properties").([]any))
 
@@ -1725,8 +1747,18 @@ func waitApplicationOperationSucceeded(ctx context.Context, conn *kinesisanalyti
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationOperationInfoDetails); ok {
-		if failureDetails := output.OperationFailureDetails; failureDetails != nil && failureDetails.ErrorInfo != nil {
-			tfresource.SetLastError(err, errors.New(aws.ToString(failureDetails.ErrorInfo.ErrorString)))
+		if failureDetails := output.OperationFailureDetails; failureDetails != nil {
+			var errs []error
+
+			if failureDetails.ErrorInfo != nil {
+				errs = append(errs, errors.New(aws.ToString(failureDetails.ErrorInfo.ErrorString)))
+			}
+
+			if v := aws.ToString(failureDetails.RollbackOperationId); v != "" {
+				errs = append(errs, fmt.Errorf("system rollback operation (%s) started", v))
+			}
+
+			tfresource.SetLastError(err, errors.Join(errs...))
 		}
 
 		return output, err
@@ -1835,6 +1867,18 @@ func expandApplicationConfiguration(vApplicationConfiguration []any) *awstypes.A
 		applicationConfiguration.ApplicationSnapshotConfiguration = applicationSnapshotConfiguration
 	}
 
+	if vApplicationSystemRollbackConfiguration, ok := mApplicationConfiguration["application_system_rollback_configuration"].([]any); ok && len(vApplicationSystemRollbackConfiguration) > 0 && vApplicationSystemRollbackConfiguration[0] != nil {
+		applicationSystemRollbackConfiguration := &awstypes.ApplicationSystemRollbackConfiguration{}
+
+		mApplicationSystemRollbackConfiguration := vApplicationSystemRollbackConfiguration[0].(map[string]any)
+
+		if vRollbackEnabled, ok := mApplicationSystemRollbackConfiguration["rollback_enabled"].(bool); ok {
+			applicationSystemRollbackConfiguration.RollbackEnabled = aws.Bool(vRollbackEnabled)
+		}
+
+		applicationConfiguration.ApplicationSystemRollbackConfiguration = applicationSystemRollbackConfiguration
+	}
+
 	if vEnvironmentProperties, ok := mApplicationConfiguration["environment_properties"].([]any); ok && len(vEnvironmentProperties) > 0 && vEnvironmentProperties[0] != nil {
 		environmentProperties := &awstypes.EnvironmentProperties{}
 
@@ -2100,6 +2144,22 @@ func expandApplicationSnapshotConfigurationUpdate(vApplicationSnapshotConfigurat
 	return applicationSnapshotConfigurationUpdate
 }
 
+func expandApplicationSystemRollbackConfigurationUpdate(vApplicationSystemRollbackConfiguration []any) *awstypes.ApplicationSystemRollbackConfigurationUpdate {
+	if len(vApplicationSystemRollbackConfiguration) == 0 || vApplicationSystemRollbackConfiguration[0] == nil {
+		return nil
+	}
+
+	applicationSystemRollbackConfigurationUpdate := &awstypes.ApplicationSystemRollbackConfigurationUpdate{}
+
+	mApplicationSystemRollbackConfiguration := vApplicationSystemRollbackConfiguration[0].(map[string]any)
+
+	if vRollbackEnabled, ok := mApplicationSystemRollbackConfiguration["rollback_enabled"].(bool); ok {
+		applicationSystemRollbackConfigurationUpdate.RollbackEnabledUpdate = aws.Bool(vRollbackEnabled)
+	}
+
+	return applicationSystemRollbackConfigurationUpdate
+}
+
 func expandCloudWatchLoggingOptions(vCloudWatchLoggingOptions []any) []awstypes.CloudWatchLoggingOption {
 	if len(vCloudWatchLoggingOptions) == 0 || vCloudWatchLoggingOptions[0] == nil {
 		return nil
@@ -2718,6 +2778,14 @@ func flattenApplicationConfigurationDescription(applicationConfigurationDescript
 		mApplicationConfiguration["application_snapshot_configuration"] = []any{mApplicationSnapshotConfiguration}
 	}
 
+	if applicationSystemRollbackConfigurationDescription := applicationConfigurationDescription.ApplicationSystemRollbackConfigurationDescription; applicationSystemRollbackConfigurationDescription != nil {
+		mApplicationSystemRollbackConfiguration := map[string]any{
+			"rollback_enabled": aws.ToBool(applicationSystemRollbackConfigurationDescription.RollbackEnabled),
+		}
+
+		mApplicationConfiguration["application_system_rollback_configuration"] = []any{mApplicationSystemRollbackConfiguration}
+	}
+
 	if environmentPropertyDescriptions := applicationConfigurationDescription.EnvironmentPropertyDescriptions; environmentPropertyDescriptions != nil && len(environmentPropertyDescriptions.PropertyGroupDescriptions) > 0 {
 		mEnvironmentProperties := map[string]any{}
 
@@ -36,6 +36,12 @@ func resourceCiphertext() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"grant_tokens": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -65,6 +71,10 @@ func resourceCiphertextCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.EncryptionContext = flex.ExpandStringValueMap(v.(map[string]any))
 	}
 
+	if v, ok := d.GetOk("grant_tokens"); ok && len(v.([]any)) > 0 {
+		input.GrantTokens = flex.ExpandStringValueList(v.([]any))
+	}
+
 	output, err := conn.Encrypt(ctx, input)
 
 	if err != nil {
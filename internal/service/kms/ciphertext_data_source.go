@@ -32,6 +32,11 @@ func dataSourceCiphertext() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"grant_tokens": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -59,6 +64,10 @@ func dataSourceCiphertextRead(ctx context.Context, d *schema.ResourceData, meta
 		input.EncryptionContext = flex.ExpandStringValueMap(v.(map[string]any))
 	}
 
+	if v, ok := d.GetOk("grant_tokens"); ok && len(v.([]any)) > 0 {
+		input.GrantTokens = flex.ExpandStringValueList(v.([]any))
+	}
+
 	output, err := conn.Encrypt(ctx, input)
 
 	if err != nil {
@@ -75,6 +75,29 @@ func TestAccKMSCiphertext_Validate_withContext(t *testing.T) {
 	})
 }
 
+func TestAccKMSCiphertext_grantTokens(t *testing.T) {
+	ctx := acctest.Context(t)
+	kmsSecretsDataSource := "data.aws_kms_secrets.test"
+	resourceName := "aws_kms_ciphertext.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCiphertextConfig_grantTokens,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ciphertext_blob"),
+					resource.TestCheckResourceAttr(resourceName, "grant_tokens.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "plaintext", kmsSecretsDataSource, "plaintext.plaintext"),
+				),
+			},
+		},
+	})
+}
+
 const testAccCiphertextConfig_basic = `
 resource "aws_kms_key" "test" {
   description             = "tf-test-acc-data-source-aws-kms-ciphertext-basic"
@@ -141,3 +164,38 @@ data "aws_kms_secrets" "test" {
   }
 }
 `
+
+const testAccCiphertextConfig_grantTokens = `
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_kms_key" "test" {
+  description             = "tf-test-acc-data-source-aws-kms-ciphertext-grant-tokens"
+  is_enabled              = true
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_kms_grant" "test" {
+  key_id            = aws_kms_key.test.key_id
+  grantee_principal = data.aws_iam_session_context.current.issuer_arn
+  operations        = ["Encrypt", "Decrypt"]
+}
+
+resource "aws_kms_ciphertext" "test" {
+  key_id       = aws_kms_key.test.key_id
+  grant_tokens = [aws_kms_grant.test.grant_token]
+
+  plaintext = "Super secret data"
+}
+
+data "aws_kms_secrets" "test" {
+  secret {
+    name    = "plaintext"
+    payload = aws_kms_ciphertext.test.ciphertext_blob
+  }
+}
+`
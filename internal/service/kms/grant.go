@@ -6,6 +6,7 @@ package kms
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"slices"
@@ -38,6 +39,7 @@ func resourceGrant() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantCreate,
 		ReadWithoutTimeout:   resourceGrantRead,
+		UpdateWithoutTimeout: resourceGrantUpdate,
 		DeleteWithoutTimeout: resourceGrantDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -55,24 +57,34 @@ func resourceGrant() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+			// Changing constraints replaces the underlying grant, see resourceGrantUpdate.
+			if d.Id() != "" && d.HasChange("constraints") {
+				if err := d.SetNewComputed("grant_id"); err != nil {
+					return err
+				}
+
+				return d.SetNewComputed("grant_token")
+			}
+
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"constraints": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"encryption_context_equals": {
 							Type:     schema.TypeMap,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							// ConflictsWith encryption_context_subset handled in Create, see grantConstraintsIsValid
 						},
 						"encryption_context_subset": {
 							Type:     schema.TypeMap,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							// ConflictsWith encryption_context_equals handled in Create, see grantConstraintsIsValid
 						},
@@ -148,15 +160,29 @@ func resourceGrantCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
 	keyID := d.Get(names.AttrKeyID).(string)
+	output, err := createGrant(ctx, conn, d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating KMS Grant for Key (%s): %s", keyID, err)
+	}
+
+	grantID := aws.ToString(output.GrantId)
+	d.SetId(grantCreateResourceID(keyID, grantID))
+	d.Set("grant_token", output.GrantToken)
+
+	return append(diags, resourceGrantRead(ctx, d, meta)...)
+}
+
+func createGrant(ctx context.Context, conn *kms.Client, d *schema.ResourceData) (*kms.CreateGrantOutput, error) {
 	input := &kms.CreateGrantInput{
 		GranteePrincipal: aws.String(d.Get("grantee_principal").(string)),
-		KeyId:            aws.String(keyID),
+		KeyId:            aws.String(d.Get(names.AttrKeyID).(string)),
 		Operations:       flex.ExpandStringyValueSet[awstypes.GrantOperation](d.Get("operations").(*schema.Set)),
 	}
 
 	if v, ok := d.GetOk("constraints"); ok && v.(*schema.Set).Len() > 0 {
 		if !grantConstraintsIsValid(v.(*schema.Set)) {
-			return sdkdiag.AppendErrorf(diags, "A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
+			return nil, errors.New("a grant constraint can't have both encryption_context_equals and encryption_context_subset set")
 		}
 
 		input.Constraints = expandGrantConstraints(v.(*schema.Set))
@@ -177,19 +203,9 @@ func resourceGrantCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	// Error Codes: https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#KMS.CreateGrant
 	// Under some circumstances a newly created IAM Role doesn't show up and causes
 	// an InvalidArnException to be thrown.
-	output, err := tfresource.RetryWhenIsOneOf3[*kms.CreateGrantOutput, *awstypes.DependencyTimeoutException, *awstypes.KMSInternalException, *awstypes.InvalidArnException](ctx, propagationTimeout, func(ctx context.Context) (*kms.CreateGrantOutput, error) {
+	return tfresource.RetryWhenIsOneOf3[*kms.CreateGrantOutput, *awstypes.DependencyTimeoutException, *awstypes.KMSInternalException, *awstypes.InvalidArnException](ctx, propagationTimeout, func(ctx context.Context) (*kms.CreateGrantOutput, error) {
 		return conn.CreateGrant(ctx, input)
 	})
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating KMS Grant for Key (%s): %s", keyID, err)
-	}
-
-	grantID := aws.ToString(output.GrantId)
-	d.SetId(grantCreateResourceID(keyID, grantID))
-	d.Set("grant_token", output.GrantToken)
-
-	return append(diags, resourceGrantRead(ctx, d, meta)...)
 }
 
 func resourceGrantRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -234,6 +250,38 @@ func resourceGrantRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	return diags
 }
 
+func resourceGrantUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	if d.HasChange("constraints") {
+		keyID, oldGrantID, err := grantParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// Grants can't be modified.
+		// Create the replacement grant before removing the old one so that the grantee doesn't lose access.
+		output, err := createGrant(ctx, conn, d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Grant (%s): creating replacement grant: %s", d.Id(), err)
+		}
+
+		grantID := aws.ToString(output.GrantId)
+		d.SetId(grantCreateResourceID(keyID, grantID))
+		d.Set("grant_token", output.GrantToken)
+
+		if grantID != oldGrantID {
+			if err := deleteGrant(ctx, conn, keyID, oldGrantID, d.Get("retire_on_delete").(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating KMS Grant (%s): deleting replaced grant (%s): %s", d.Id(), oldGrantID, err)
+			}
+		}
+	}
+
+	return append(diags, resourceGrantRead(ctx, d, meta)...)
+}
+
 func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -243,14 +291,24 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.Get("retire_on_delete").(bool) {
-		log.Printf("[DEBUG] Retiring KMS Grant: %s", d.Id())
+	if err := deleteGrant(ctx, conn, keyID, grantID, d.Get("retire_on_delete").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting KMS Grant (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// deleteGrant retires or revokes the specified grant and waits for it to disappear.
+func deleteGrant(ctx context.Context, conn *kms.Client, keyID, grantID string, retire bool) error {
+	var err error
+	if retire {
+		log.Printf("[DEBUG] Retiring KMS Grant: %s", grantCreateResourceID(keyID, grantID))
 		_, err = conn.RetireGrant(ctx, &kms.RetireGrantInput{
 			GrantId: aws.String(grantID),
 			KeyId:   aws.String(keyID),
 		})
 	} else {
-		log.Printf("[DEBUG] Revoking KMS Grant: %s", d.Id())
+		log.Printf("[DEBUG] Revoking KMS Grant: %s", grantCreateResourceID(keyID, grantID))
 		_, err = conn.RevokeGrant(ctx, &kms.RevokeGrantInput{
 			GrantId: aws.String(grantID),
 			KeyId:   aws.String(keyID),
@@ -258,11 +316,11 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting KMS Grant (%s): %s", d.Id(), err)
+		return err
 	}
 
 	_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
@@ -270,10 +328,10 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for KMS Grant (%s) delete: %s", d.Id(), err)
+		return fmt.Errorf("waiting for delete: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func findGrant(ctx context.Context, conn *kms.Client, input *kms.ListGrantsInput, filter tfslices.Predicate[*awstypes.GrantListEntry]) (*awstypes.GrantListEntry, error) {
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	codeSigningViolationReasonUnsigned           = "UNSIGNED"
+	codeSigningViolationReasonUntrustedPublisher = "UNTRUSTED_PUBLISHER"
+)
+
+// @SDKDataSource("aws_lambda_code_signing_config_violations", name="Code Signing Config Violations")
+func dataSourceCodeSigningConfigViolations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCodeSigningConfigViolationsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"attached_functions_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"function_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"violations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"function_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"function_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_profile_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCodeSigningConfigViolationsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	config, err := findCodeSigningConfigByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Code Signing Config (%s): %s", arn, err)
+	}
+
+	allowedProfileVersionARNs := make(map[string]struct{})
+	if v := config.AllowedPublishers; v != nil {
+		for _, v := range v.SigningProfileVersionArns {
+			allowedProfileVersionARNs[v] = struct{}{}
+		}
+	}
+
+	var attachedFunctionARNs map[string]struct{}
+	if d.Get("attached_functions_only").(bool) {
+		functionARNs, err := findFunctionARNsByCodeSigningConfigARN(ctx, conn, arn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing Lambda Functions by Code Signing Config (%s): %s", arn, err)
+		}
+
+		attachedFunctionARNs = make(map[string]struct{}, len(functionARNs))
+		for _, v := range functionARNs {
+			attachedFunctionARNs[v] = struct{}{}
+		}
+	}
+
+	functions, err := findFunctionConfigurations(ctx, conn, &lambda.ListFunctionsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Lambda Functions: %s", err)
+	}
+
+	var functionARNs []string
+	var violations []any
+
+	for _, function := range functions {
+		// Code signing is not supported for functions defined as container images.
+		if function.PackageType == awstypes.PackageTypeImage {
+			continue
+		}
+
+		functionARN := aws.ToString(function.FunctionArn)
+
+		if attachedFunctionARNs != nil {
+			if _, ok := attachedFunctionARNs[functionARN]; !ok {
+				continue
+			}
+		}
+
+		signingProfileVersionARN := aws.ToString(function.SigningProfileVersionArn)
+
+		var reason string
+		if signingProfileVersionARN == "" {
+			reason = codeSigningViolationReasonUnsigned
+		} else if _, ok := allowedProfileVersionARNs[signingProfileVersionARN]; !ok {
+			reason = codeSigningViolationReasonUntrustedPublisher
+		} else {
+			continue
+		}
+
+		functionARNs = append(functionARNs, functionARN)
+		violations = append(violations, map[string]any{
+			"function_arn":                functionARN,
+			"function_name":               aws.ToString(function.FunctionName),
+			"reason":                      reason,
+			"signing_profile_version_arn": signingProfileVersionARN,
+		})
+	}
+
+	d.SetId(aws.ToString(config.CodeSigningConfigArn))
+	d.Set("function_arns", functionARNs)
+	if err := d.Set("violations", violations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting violations: %s", err)
+	}
+
+	return diags
+}
+
+func findFunctionConfigurations(ctx context.Context, conn *lambda.Client, input *lambda.ListFunctionsInput) ([]awstypes.FunctionConfiguration, error) {
+	var output []awstypes.FunctionConfiguration
+
+	pages := lambda.NewListFunctionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Functions...)
+	}
+
+	return output, nil
+}
+
+func findFunctionARNsByCodeSigningConfigARN(ctx context.Context, conn *lambda.Client, arn string) ([]string, error) {
+	input := &lambda.ListFunctionsByCodeSigningConfigInput{
+		CodeSigningConfigArn: aws.String(arn),
+	}
+	var output []string
+
+	pages := lambda.NewListFunctionsByCodeSigningConfigPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.FunctionArns...)
+	}
+
+	return output, nil
+}
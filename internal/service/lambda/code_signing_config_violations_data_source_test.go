@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaCodeSigningConfigViolationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if curr := acctest.Region(); !tflambda.SignerServiceIsAvailable(curr) {
+		t.Skipf("Lambda code signing config is not supported in %s region", curr)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_code_signing_config_violations.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSignerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSigningConfigViolationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arns.0", functionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "violations.0.function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.reason", "UNSIGNED"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.signing_profile_version_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCodeSigningConfigViolationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_cscCreate(rName), `
+data "aws_lambda_code_signing_config_violations" "test" {
+  arn                     = aws_lambda_code_signing_config.code_signing_config_1.arn
+  attached_functions_only = true
+
+  depends_on = [aws_lambda_function.test]
+}
+`)
+}
@@ -16,25 +16,31 @@ var (
 	ResourceLayerVersionPermission       = resourceLayerVersionPermission
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
+	ResourceTriggerS3                    = resourceTriggerS3
+	ResourceTriggerSNS                   = resourceTriggerSNS
 
-	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
-	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
-	FindEventSourceMappingByID                   = findEventSourceMappingByID
-	FindFunctionByName                           = findFunctionByName
-	FindFunctionEventInvokeConfigByTwoPartKey    = findFunctionEventInvokeConfigByTwoPartKey
-	FindFunctionRecursionConfigByName            = findFunctionRecursionConfigByName
-	FindFunctionURLByTwoPartKey                  = findFunctionURLByTwoPartKey
-	FindLayerVersionByTwoPartKey                 = findLayerVersionByTwoPartKey
-	FindLayerVersionPolicyByTwoPartKey           = findLayerVersionPolicyByTwoPartKey
-	FindPolicyStatementByTwoPartKey              = findPolicyStatementByTwoPartKey
-	FindProvisionedConcurrencyConfigByTwoPartKey = findProvisionedConcurrencyConfigByTwoPartKey
-	FindRuntimeManagementConfigByTwoPartKey      = findRuntimeManagementConfigByTwoPartKey
-	FunctionEventInvokeConfigParseResourceID     = functionEventInvokeConfigParseResourceID
-	GetFunctionNameFromARN                       = getFunctionNameFromARN
-	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
-	LayerVersionParseResourceID                  = layerVersionParseResourceID
-	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
-	SignerServiceIsAvailable                     = signerServiceIsAvailable
+	FindAliasByTwoPartKey                             = findAliasByTwoPartKey
+	FindBucketLambdaFunctionConfigurationByTwoPartKey = findBucketLambdaFunctionConfigurationByTwoPartKey
+	FindCodeSigningConfigByARN                        = findCodeSigningConfigByARN
+	FindEventSourceMappingByID                        = findEventSourceMappingByID
+	FindFunctionByName                                = findFunctionByName
+	FindFunctionEventInvokeConfigByTwoPartKey         = findFunctionEventInvokeConfigByTwoPartKey
+	FindFunctionRecursionConfigByName                 = findFunctionRecursionConfigByName
+	FindFunctionURLByTwoPartKey                       = findFunctionURLByTwoPartKey
+	FindLayerVersionByTwoPartKey                      = findLayerVersionByTwoPartKey
+	FindLayerVersionPolicyByTwoPartKey                = findLayerVersionPolicyByTwoPartKey
+	FindPolicyStatementByTwoPartKey                   = findPolicyStatementByTwoPartKey
+	FindProvisionedConcurrencyConfigByTwoPartKey      = findProvisionedConcurrencyConfigByTwoPartKey
+	FindRuntimeManagementConfigByTwoPartKey           = findRuntimeManagementConfigByTwoPartKey
+	FindSNSSubscriptionAttributesByARN                = findSNSSubscriptionAttributesByARN
+	FunctionEventInvokeConfigParseResourceID          = functionEventInvokeConfigParseResourceID
+	GetFunctionNameFromARN                            = getFunctionNameFromARN
+	GetQualifierFromAliasOrVersionARN                 = getQualifierFromAliasOrVersionARN
+	LayerVersionParseResourceID                       = layerVersionParseResourceID
+	LayerVersionPermissionParseResourceID             = layerVersionPermissionParseResourceID
+	ReadSourceURLContents                             = readSourceURLContents
+	RemoveTriggerPermission                           = removeTriggerPermission
+	SignerServiceIsAvailable                          = signerServiceIsAvailable
 
 	ValidFunctionName               = validFunctionName
 	ValidPermissionAction           = validPermissionAction
@@ -141,6 +141,26 @@ func resourceFunction() *schema.Resource {
 					return false
 				},
 			},
+			"event_invoke_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_event_age_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(60, 21600),
+						},
+						"maximum_retry_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(0, 2),
+						},
+					},
+				},
+			},
 			"ephemeral_storage": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -183,7 +203,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_url"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -222,7 +242,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_url"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -307,6 +327,11 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecursiveLoop](),
+			},
 			"replace_security_groups_on_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -333,10 +358,29 @@ func resourceFunction() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.Runtime](),
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"update_runtime_on": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.UpdateRuntimeOn](),
+						},
+					},
+				},
+			},
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_url"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -347,7 +391,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_url"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -390,12 +434,25 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_hash": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_url"},
+				ValidateFunc: validation.StringMatch(sourceHashRegexp, "must be a hex-encoded SHA-256 hash"),
+			},
 			"source_kms_key_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ValidateFunc:  verify.ValidARN,
 				ConflictsWith: []string{"image_uri"},
 			},
+			"source_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_url"},
+				RequiredWith: []string{"source_hash"},
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -509,6 +566,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta an
 			return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 		}
 
+		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("source_url"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		zipFile, err := readSourceURLContents(ctx, v.(string), d.Get("source_hash").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "downloading ZIP file (%s): %s", v, err)
+		}
+
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
@@ -635,6 +703,24 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		if err := putFunctionRuntimeManagementConfig(ctx, conn, d.Id(), v.([]any)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if v, ok := d.GetOk("event_invoke_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		if err := putFunctionEventInvokeConfig(ctx, conn, d.Id(), v.([]any)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if v, ok := d.GetOk("recursive_loop"); ok {
+		if err := putFunctionRecursionConfig(ctx, conn, d.Id(), awstypes.RecursiveLoop(v.(string))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -702,6 +788,24 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set("ephemeral_storage", flattenEphemeralStorage(function.EphemeralStorage)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ephemeral_storage: %s", err)
 	}
+	// Asynchronous invocation configuration is only read when configured, as it may instead be managed by the
+	// aws_lambda_function_event_invoke_config resource.
+	if v, ok := d.GetOk("event_invoke_config"); ok && len(v.([]any)) > 0 {
+		output, err := findFunctionEventInvokeConfigByTwoPartKey(ctx, conn, d.Id(), "")
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("event_invoke_config", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) event invoke config: %s", d.Id(), err)
+		default:
+			if err := d.Set("event_invoke_config", flattenFunctionEventInvokeConfig(output)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting event_invoke_config: %s", err)
+			}
+		}
+	} else {
+		d.Set("event_invoke_config", nil)
+	}
 	if err := d.Set("file_system_config", flattenFileSystemConfigs(function.FileSystemConfigs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting file_system_config: %s", err)
 	}
@@ -728,8 +832,35 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta any)
 	} else {
 		d.Set("reserved_concurrent_executions", -1)
 	}
+	// Recursive loop detection is only read when configured, as it may instead be managed by the
+	// aws_lambda_function_recursion_config resource.
+	if _, ok := d.GetOk("recursive_loop"); ok {
+		output, err := findFunctionRecursionConfigByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+
+		d.Set("recursive_loop", output.RecursiveLoop)
+	} else {
+		d.Set("recursive_loop", nil)
+	}
 	d.Set(names.AttrRole, function.Role)
 	d.Set("runtime", function.Runtime)
+	// Runtime management configuration is only read when configured, avoiding an extra API call for every function.
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]any)) > 0 && function.PackageType == awstypes.PackageTypeZip {
+		output, err := findRuntimeManagementConfigByTwoPartKey(ctx, conn, d.Id(), "")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) runtime management config: %s", d.Id(), err)
+		}
+
+		if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(output)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting runtime_management_config: %s", err)
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
@@ -1010,6 +1141,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 			}
 
+			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("source_url"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			zipFile, err := readSourceURLContents(ctx, v.(string), d.Get("source_hash").(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "downloading ZIP file (%s): %s", v, err)
+			}
+
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
@@ -1038,6 +1180,51 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	}
 	codeUpdateCompleted = true
 
+	if d.HasChange("runtime_management_config") {
+		tfList := d.Get("runtime_management_config").([]any)
+		if len(tfList) == 0 || tfList[0] == nil {
+			// Revert to the default runtime update mode.
+			tfList = []any{map[string]any{
+				"runtime_version_arn": "",
+				"update_runtime_on":   string(awstypes.UpdateRuntimeOnAuto),
+			}}
+		}
+
+		if err := putFunctionRuntimeManagementConfig(ctx, conn, d.Id(), tfList); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChange("event_invoke_config") {
+		if tfList := d.Get("event_invoke_config").([]any); len(tfList) > 0 && tfList[0] != nil {
+			if err := putFunctionEventInvokeConfig(ctx, conn, d.Id(), tfList); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		} else {
+			input := lambda.DeleteFunctionEventInvokeConfigInput{
+				FunctionName: aws.String(d.Id()),
+			}
+
+			_, err := conn.DeleteFunctionEventInvokeConfig(ctx, &input)
+
+			if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return sdkdiag.AppendErrorf(diags, "deleting Lambda Function (%s) event invoke config: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("recursive_loop") {
+		recursiveLoop := awstypes.RecursiveLoop(d.Get("recursive_loop").(string))
+		if recursiveLoop == "" {
+			// Revert to the default recursive loop detection behavior.
+			recursiveLoop = awstypes.RecursiveLoopTerminate
+		}
+
+		if err := putFunctionRecursionConfig(ctx, conn, d.Id(), recursiveLoop); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("reserved_concurrent_executions") {
 		if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 			input := lambda.PutFunctionConcurrencyInput{
@@ -1505,6 +1692,8 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
+		d.HasChange("source_hash") ||
+		d.HasChange("source_url") ||
 		d.HasChange(names.AttrS3Bucket) ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
@@ -1734,6 +1923,87 @@ func flattenEphemeralStorage(apiObject *awstypes.EphemeralStorage) []any {
 	return []any{tfMap}
 }
 
+func putFunctionRuntimeManagementConfig(ctx context.Context, conn *lambda.Client, functionName string, tfList []any) error {
+	tfMap := tfList[0].(map[string]any)
+	input := lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: awstypes.UpdateRuntimeOn(tfMap["update_runtime_on"].(string)),
+	}
+
+	if v, ok := tfMap["runtime_version_arn"].(string); ok && v != "" {
+		input.RuntimeVersionArn = aws.String(v)
+	}
+
+	_, err := conn.PutRuntimeManagementConfig(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("setting Lambda Function (%s) runtime management config: %w", functionName, err)
+	}
+
+	return nil
+}
+
+func putFunctionEventInvokeConfig(ctx context.Context, conn *lambda.Client, functionName string, tfList []any) error {
+	tfMap := tfList[0].(map[string]any)
+	input := lambda.PutFunctionEventInvokeConfigInput{
+		FunctionName:         aws.String(functionName),
+		MaximumRetryAttempts: aws.Int32(int32(tfMap["maximum_retry_attempts"].(int))),
+	}
+
+	if v, ok := tfMap["maximum_event_age_in_seconds"].(int); ok && v != 0 {
+		input.MaximumEventAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	_, err := conn.PutFunctionEventInvokeConfig(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("setting Lambda Function (%s) event invoke config: %w", functionName, err)
+	}
+
+	return nil
+}
+
+func flattenFunctionEventInvokeConfig(apiObject *lambda.GetFunctionEventInvokeConfigOutput) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"maximum_event_age_in_seconds": aws.ToInt32(apiObject.MaximumEventAgeInSeconds),
+		"maximum_retry_attempts":       aws.ToInt32(apiObject.MaximumRetryAttempts),
+	}
+
+	return []any{tfMap}
+}
+
+func putFunctionRecursionConfig(ctx context.Context, conn *lambda.Client, functionName string, recursiveLoop awstypes.RecursiveLoop) error {
+	input := lambda.PutFunctionRecursionConfigInput{
+		FunctionName:  aws.String(functionName),
+		RecursiveLoop: recursiveLoop,
+	}
+
+	_, err := conn.PutFunctionRecursionConfig(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("setting Lambda Function (%s) recursion config: %w", functionName, err)
+	}
+
+	return nil
+}
+
+func flattenRuntimeManagementConfig(apiObject *lambda.GetRuntimeManagementConfigOutput) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"runtime_version_arn": aws.ToString(apiObject.RuntimeVersionArn),
+		"update_runtime_on":   string(apiObject.UpdateRuntimeOn),
+	}
+
+	return []any{tfMap}
+}
+
 func expandSnapStart(tfList []any) *awstypes.SnapStart {
 	apiObject := &awstypes.SnapStart{
 		ApplyOn: awstypes.SnapStartApplyOnNone,
@@ -1768,7 +2038,7 @@ func flattenSnapStart(apiObject *awstypes.SnapStartResponse) []any {
 // Therefore, reset them to the previous value when the update fails.
 // https://developer.hashicorp.com/terraform/plugin/framework/diagnostics#how-errors-affect-state
 func resetNonRefreshableAttributes(d *schema.ResourceData) {
-	for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash", "filename"} {
+	for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash", "filename", "source_hash", "source_url"} {
 		if d.HasChange(key) {
 			old, _ := d.GetChange(key)
 			d.Set(key, old)
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -63,21 +64,25 @@ func resourceFunctionURL() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      sdkv2.StringCaseInsensitiveSetFunc,
 						},
 						"allow_methods": {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      sdkv2.StringCaseInsensitiveSetFunc,
 						},
 						"allow_origins": {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      sdkv2.StringCaseInsensitiveSetFunc,
 						},
 						"expose_headers": {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      sdkv2.StringCaseInsensitiveSetFunc,
 						},
 						"max_age": {
 							Type:         schema.TypeInt,
@@ -117,6 +122,8 @@ func resourceFunctionURL() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: checkInvokeModeForFunctionRuntime,
 	}
 }
 
@@ -357,6 +364,39 @@ func functionURLParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]sQUALIFIER or FUNCTION-NAME", id, functionURLResourceIDSeparator)
 }
 
+// checkInvokeModeForFunctionRuntime verifies at plan time that response streaming is supported by the function's runtime.
+// Response streaming is only supported by Node.js managed runtimes and OS-only (custom) runtimes.
+func checkInvokeModeForFunctionRuntime(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if awstypes.InvokeMode(d.Get("invoke_mode").(string)) != awstypes.InvokeModeResponseStream {
+		return nil
+	}
+
+	if !d.NewValueKnown("function_name") || !d.NewValueKnown("qualifier") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	name, qualifier := d.Get("function_name").(string), d.Get("qualifier").(string)
+	function, err := findFunctionConfigurationByTwoPartKey(ctx, conn, name, qualifier)
+
+	// The function may not exist yet.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Lambda Function (%s): %w", name, err)
+	}
+
+	// Container image functions have no managed runtime.
+	if runtime := string(function.Runtime); runtime != "" && !strings.HasPrefix(runtime, "nodejs") && !strings.HasPrefix(runtime, "provided") {
+		return fmt.Errorf("invoke_mode %s is not supported by Lambda Function (%s) runtime %s", awstypes.InvokeModeResponseStream, name, runtime)
+	}
+
+	return nil
+}
+
 func expandCors(tfMap map[string]any) *awstypes.Cors {
 	if tfMap == nil {
 		return nil
@@ -403,19 +443,19 @@ func flattenCors(apiObject *awstypes.Cors) map[string]any {
 	}
 
 	if v := apiObject.AllowHeaders; v != nil {
-		tfMap["allow_headers"] = v
+		tfMap["allow_headers"] = flex.FlattenStringValueSetCaseInsensitive(v)
 	}
 
 	if v := apiObject.AllowMethods; v != nil {
-		tfMap["allow_methods"] = v
+		tfMap["allow_methods"] = flex.FlattenStringValueSetCaseInsensitive(v)
 	}
 
 	if v := apiObject.AllowOrigins; v != nil {
-		tfMap["allow_origins"] = v
+		tfMap["allow_origins"] = flex.FlattenStringValueSetCaseInsensitive(v)
 	}
 
 	if v := apiObject.ExposeHeaders; v != nil {
-		tfMap["expose_headers"] = v
+		tfMap["expose_headers"] = flex.FlattenStringValueSetCaseInsensitive(v)
 	}
 
 	if v := apiObject.MaxAge; v != nil {
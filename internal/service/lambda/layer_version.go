@@ -84,7 +84,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_url"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -105,19 +105,19 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_url"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_url"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_url"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -143,6 +143,21 @@ func resourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_hash": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_url"},
+				ValidateFunc: validation.StringMatch(sourceHashRegexp, "must be a hex-encoded SHA-256 hash"),
+			},
+			"source_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				RequiredWith:  []string{"source_hash"},
+				ValidateFunc:  validation.IsURLWithHTTPS,
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -160,9 +175,10 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 	s3Bucket, bucketOk := d.GetOk(names.AttrS3Bucket)
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
+	sourceURL, hasSourceURL := d.GetOk("source_url")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !hasSourceURL && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_url or s3_* attributes must be set")
 	}
 
 	var layerContent *awstypes.LayerVersionContentInput
@@ -175,6 +191,18 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 			return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", filename, err)
 		}
 
+		layerContent = &awstypes.LayerVersionContentInput{
+			ZipFile: file,
+		}
+	} else if hasSourceURL {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		file, err := readSourceURLContents(ctx, sourceURL.(string), d.Get("source_hash").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "downloading ZIP file (%s): %s", sourceURL, err)
+		}
+
 		layerContent = &awstypes.LayerVersionContentInput{
 			ZipFile: file,
 		}
@@ -73,6 +73,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Code Signing Config",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceCodeSigningConfigViolations,
+			TypeName: "aws_lambda_code_signing_config_violations",
+			Name:     "Code Signing Config Violations",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourceFunction,
 			TypeName: "aws_lambda_function",
@@ -197,6 +203,18 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Provisioned Concurrency Config",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceTriggerS3,
+			TypeName: "aws_lambda_trigger_s3",
+			Name:     "S3 Trigger",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceTriggerSNS,
+			TypeName: "aws_lambda_trigger_sns",
+			Name:     "SNS Trigger",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly to Lambda.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourceURLMaxContentLength = 50 * 1024 * 1024
+)
+
+var (
+	sourceHashRegexp = regexache.MustCompile(`^[0-9A-Fa-f]{64}$`)
+)
+
+// readSourceURLContents downloads the ZIP archive at the specified HTTPS URL into memory,
+// computing its SHA-256 digest as the response body is streamed and verifying it against the expected hex-encoded hash.
+// Usually a call to this function is protected by an exclusive lock (per resource type)
+// to prevent memory exhaustion (e.g. `conns.GlobalMutexKV.Lock`).
+func readSourceURLContents(ctx context.Context, url, hash string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET (%s): %w", url, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP GET (%s): unexpected status %s", url, response.Status)
+	}
+
+	if response.ContentLength > sourceURLMaxContentLength {
+		return nil, fmt.Errorf("HTTP GET (%s): content length (%d) exceeds maximum (%d)", url, response.ContentLength, sourceURLMaxContentLength)
+	}
+
+	var buf bytes.Buffer
+	hasher := sha256.New()
+	n, err := io.Copy(io.MultiWriter(&buf, hasher), io.LimitReader(response.Body, sourceURLMaxContentLength+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading response body (%s): %w", url, err)
+	}
+
+	if n > sourceURLMaxContentLength {
+		return nil, fmt.Errorf("HTTP GET (%s): content length exceeds maximum (%d)", url, sourceURLMaxContentLength)
+	}
+
+	if got, want := hex.EncodeToString(hasher.Sum(nil)), strings.ToLower(hash); got != want {
+		return nil, fmt.Errorf("SHA-256 checksum of %s (%s) does not match source_hash (%s)", url, got, want)
+	}
+
+	return buf.Bytes(), nil
+}
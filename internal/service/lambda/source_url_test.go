@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestReadSourceURLContents(t *testing.T) {
+	t.Parallel()
+
+	const content = "not really a ZIP archive"
+	sum := sha256.Sum256([]byte(content))
+	hash := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifact.zip" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, content)
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := map[string]struct {
+		path          string
+		hash          string
+		expectedError string
+	}{
+		"valid": {
+			path: "/artifact.zip",
+			hash: hash,
+		},
+		"valid upper case hash": {
+			path: "/artifact.zip",
+			hash: strings.ToUpper(hash),
+		},
+		"hash mismatch": {
+			path:          "/artifact.zip",
+			hash:          strings.Repeat("0", 64),
+			expectedError: "does not match source_hash",
+		},
+		"not found": {
+			path:          "/missing.zip",
+			hash:          hash,
+			expectedError: "unexpected status 404",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tflambda.ReadSourceURLContents(t.Context(), server.URL+testCase.path, testCase.hash)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got %s", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != content {
+				t.Errorf("got %q, want %q", got, content)
+			}
+		})
+	}
+}
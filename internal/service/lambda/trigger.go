@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Helpers shared by the aws_lambda_trigger_* resources, which manage an event source's
+// notification configuration together with the Lambda permission that allows it to invoke the function.
+
+// addTriggerPermission grants the specified service principal permission to invoke the function
+// on behalf of the source resource and returns the generated statement ID.
+func addTriggerPermission(ctx context.Context, conn *lambda.Client, functionARN, principal, sourceARN, sourceAccount, statementIDPrefix string) (string, error) {
+	statementID := create.Name("", statementIDPrefix)
+
+	// See resourcePermissionCreate.
+	conns.GlobalMutexKV.Lock(functionARN)
+	defer conns.GlobalMutexKV.Unlock(functionARN)
+
+	input := lambda.AddPermissionInput{
+		Action:       aws.String("lambda:InvokeFunction"),
+		FunctionName: aws.String(functionARN),
+		Principal:    aws.String(principal),
+		SourceArn:    aws.String(sourceARN),
+		StatementId:  aws.String(statementID),
+	}
+	if sourceAccount != "" {
+		input.SourceAccount = aws.String(sourceAccount)
+	}
+
+	// Retry for IAM and Lambda eventual consistency.
+	_, err := tfresource.RetryWhenIsOneOf2[any, *awstypes.ResourceConflictException, *awstypes.ResourceNotFoundException](ctx, lambdaPropagationTimeout,
+		func(ctx context.Context) (any, error) {
+			return conn.AddPermission(ctx, &input)
+		})
+
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tfresource.RetryWhenNotFound(ctx, lambdaPropagationTimeout, func(ctx context.Context) (*policyStatement, error) {
+		return findPolicyStatementByTwoPartKey(ctx, conn, functionARN, statementID, "")
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return statementID, nil
+}
+
+func removeTriggerPermission(ctx context.Context, conn *lambda.Client, functionARN, statementID string) error {
+	// See resourcePermissionDelete.
+	conns.GlobalMutexKV.Lock(functionARN)
+	defer conns.GlobalMutexKV.Unlock(functionARN)
+
+	input := lambda.RemovePermissionInput{
+		FunctionName: aws.String(functionARN),
+		StatementId:  aws.String(statementID),
+	}
+
+	_, err := conn.RemovePermission(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, lambdaPropagationTimeout, func(ctx context.Context) (any, error) {
+		return findPolicyStatementByTwoPartKey(ctx, conn, functionARN, statementID, "")
+	})
+
+	return err
+}
@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lambda_trigger_s3", name="S3 Trigger")
+func resourceTriggerS3() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTriggerS3Create,
+		ReadWithoutTimeout:   resourceTriggerS3Read,
+		UpdateWithoutTimeout: resourceTriggerS3Update,
+		DeleteWithoutTimeout: resourceTriggerS3Delete,
+
+		CustomizeDiff: resourceTriggerS3CustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[s3types.Event](),
+				},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"notification_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statement_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTriggerS3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.LambdaClient(ctx)
+
+	bucket, functionARN := d.Get(names.AttrBucket).(string), d.Get("function_arn").(string)
+	statementID, err := addTriggerS3Permission(ctx, c, functionARN, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding Lambda S3 Trigger (%s/%s) permission: %s", bucket, functionARN, err)
+	}
+
+	notificationID := create.Name("", "tf-lambda-trigger-")
+	configuration := expandTriggerS3LambdaFunctionConfiguration(d, notificationID)
+	err = modifyBucketLambdaFunctionConfigurations(ctx, c.S3Client(ctx), bucket, func(configurations []s3types.LambdaFunctionConfiguration) []s3types.LambdaFunctionConfiguration {
+		return append(configurations, configuration)
+	})
+
+	if err != nil {
+		// Don't leave the permission behind without its notification.
+		if err := removeTriggerPermission(ctx, conn, functionARN, statementID); err != nil {
+			log.Printf("[WARN] removing Lambda Permission (%s/%s): %s", functionARN, statementID, err)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "creating Lambda S3 Trigger (%s/%s) notification: %s", bucket, functionARN, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{bucket, notificationID}, triggerS3ResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+	d.Set("notification_id", notificationID)
+	d.Set("statement_id", statementID)
+
+	return append(diags, resourceTriggerS3Read(ctx, d, meta)...)
+}
+
+func resourceTriggerS3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.LambdaClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), triggerS3ResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	bucket, notificationID := parts[0], parts[1]
+	configuration, err := tfresource.RetryWhenNewResourceNotFound(ctx, lambdaPropagationTimeout, func(ctx context.Context) (*s3types.LambdaFunctionConfiguration, error) {
+		return findBucketLambdaFunctionConfigurationByTwoPartKey(ctx, c.S3Client(ctx), bucket, notificationID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda S3 Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda S3 Trigger (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, bucket)
+	d.Set("events", configuration.Events)
+	d.Set("filter_prefix", "")
+	d.Set("filter_suffix", "")
+	if v := configuration.Filter; v != nil && v.Key != nil {
+		for _, rule := range v.Key.FilterRules {
+			switch {
+			case strings.EqualFold(string(rule.Name), string(s3types.FilterRuleNamePrefix)):
+				d.Set("filter_prefix", rule.Value)
+			case strings.EqualFold(string(rule.Name), string(s3types.FilterRuleNameSuffix)):
+				d.Set("filter_suffix", rule.Value)
+			}
+		}
+	}
+	d.Set("function_arn", configuration.LambdaFunctionArn)
+	d.Set("notification_id", notificationID)
+
+	// The notification entry is kept when only the permission is missing so that it can be re-added on update.
+	_, err = tfresource.RetryWhenNewResourceNotFound(ctx, lambdaPropagationTimeout, func(ctx context.Context) (*policyStatement, error) {
+		return findPolicyStatementByTwoPartKey(ctx, conn, aws.ToString(configuration.LambdaFunctionArn), d.Get("statement_id").(string), "")
+	}, d.IsNewResource())
+
+	switch {
+	case !d.IsNewResource() && tfresource.NotFound(err):
+		log.Printf("[WARN] Lambda S3 Trigger (%s) permission not found", d.Id())
+		d.Set("statement_id", "")
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Lambda S3 Trigger (%s) permission: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTriggerS3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+
+	bucket, notificationID := d.Get(names.AttrBucket).(string), d.Get("notification_id").(string)
+
+	if d.Get("statement_id").(string) == "" {
+		functionARN := d.Get("function_arn").(string)
+		statementID, err := addTriggerS3Permission(ctx, c, functionARN, bucket)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "adding Lambda S3 Trigger (%s) permission: %s", d.Id(), err)
+		}
+
+		d.Set("statement_id", statementID)
+	}
+
+	if d.HasChanges("events", "filter_prefix", "filter_suffix") {
+		configuration := expandTriggerS3LambdaFunctionConfiguration(d, notificationID)
+		err := modifyBucketLambdaFunctionConfigurations(ctx, c.S3Client(ctx), bucket, func(configurations []s3types.LambdaFunctionConfiguration) []s3types.LambdaFunctionConfiguration {
+			for i, v := range configurations {
+				if aws.ToString(v.Id) == notificationID {
+					configurations[i] = configuration
+					return configurations
+				}
+			}
+
+			return append(configurations, configuration)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda S3 Trigger (%s) notification: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTriggerS3Read(ctx, d, meta)...)
+}
+
+func resourceTriggerS3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+
+	bucket, notificationID := d.Get(names.AttrBucket).(string), d.Get("notification_id").(string)
+
+	log.Printf("[DEBUG] Deleting Lambda S3 Trigger: %s", d.Id())
+	err := modifyBucketLambdaFunctionConfigurations(ctx, c.S3Client(ctx), bucket, func(configurations []s3types.LambdaFunctionConfiguration) []s3types.LambdaFunctionConfiguration {
+		return slices.DeleteFunc(configurations, func(v s3types.LambdaFunctionConfiguration) bool {
+			return aws.ToString(v.Id) == notificationID
+		})
+	})
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda S3 Trigger (%s) notification: %s", d.Id(), err)
+	}
+
+	if err := removeTriggerPermission(ctx, c.LambdaClient(ctx), d.Get("function_arn").(string), d.Get("statement_id").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda S3 Trigger (%s) permission: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTriggerS3CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	// A permission removed out of band is re-added in place.
+	if d.Id() != "" && d.Get("statement_id").(string) == "" {
+		return d.SetNewComputed("statement_id")
+	}
+
+	return nil
+}
+
+const (
+	triggerS3ResourceIDPartCount = 2
+)
+
+func addTriggerS3Permission(ctx context.Context, c *conns.AWSClient, functionARN, bucket string) (string, error) {
+	bucketARN := arn.ARN{
+		Partition: c.Partition(ctx),
+		Service:   "s3",
+		Resource:  bucket,
+	}.String()
+
+	return addTriggerPermission(ctx, c.LambdaClient(ctx), functionARN, "s3.amazonaws.com", bucketARN, c.AccountID(ctx), "AllowExecutionFromS3Bucket-")
+}
+
+func expandTriggerS3LambdaFunctionConfiguration(d *schema.ResourceData, notificationID string) s3types.LambdaFunctionConfiguration {
+	apiObject := s3types.LambdaFunctionConfiguration{
+		Events:            flex.ExpandStringyValueSet[s3types.Event](d.Get("events").(*schema.Set)),
+		Id:                aws.String(notificationID),
+		LambdaFunctionArn: aws.String(d.Get("function_arn").(string)),
+	}
+
+	var filterRules []s3types.FilterRule
+	if v, ok := d.GetOk("filter_prefix"); ok {
+		filterRules = append(filterRules, s3types.FilterRule{
+			Name:  s3types.FilterRuleNamePrefix,
+			Value: aws.String(v.(string)),
+		})
+	}
+	if v, ok := d.GetOk("filter_suffix"); ok {
+		filterRules = append(filterRules, s3types.FilterRule{
+			Name:  s3types.FilterRuleNameSuffix,
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	if len(filterRules) > 0 {
+		apiObject.Filter = &s3types.NotificationConfigurationFilter{
+			Key: &s3types.S3KeyFilter{
+				FilterRules: filterRules,
+			},
+		}
+	}
+
+	return apiObject
+}
+
+// modifyBucketLambdaFunctionConfigurations applies f to the bucket's Lambda function notification configurations
+// and writes back the bucket's notification configuration. Other notification configurations are preserved.
+// Modifications to the same bucket's notification configuration are serialized.
+func modifyBucketLambdaFunctionConfigurations(ctx context.Context, conn *s3.Client, bucket string, f func([]s3types.LambdaFunctionConfiguration) []s3types.LambdaFunctionConfiguration) error {
+	mutexKey := tfs3.BucketNotificationMutexKey(bucket)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket)
+
+	if err != nil {
+		return err
+	}
+
+	input := s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+		NotificationConfiguration: &s3types.NotificationConfiguration{
+			EventBridgeConfiguration:     output.EventBridgeConfiguration,
+			LambdaFunctionConfigurations: f(output.LambdaFunctionConfigurations),
+			QueueConfigurations:          output.QueueConfigurations,
+			TopicConfigurations:          output.TopicConfigurations,
+		},
+	}
+
+	// Retry while the Lambda permission propagates.
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, lambdaPropagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.PutBucketNotificationConfiguration(ctx, &input)
+	}, "InvalidArgument", "Unable to validate the following destination configurations")
+
+	return err
+}
+
+func findBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*s3.GetBucketNotificationConfigurationOutput, error) {
+	input := s3.GetBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketNotificationConfiguration(ctx, &input)
+
+	if errs.IsA[*s3types.NoSuchBucket](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findBucketLambdaFunctionConfigurationByTwoPartKey(ctx context.Context, conn *s3.Client, bucket, notificationID string) (*s3types.LambdaFunctionConfiguration, error) {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.LambdaFunctionConfigurations {
+		if aws.ToString(v.Id) == notificationID {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: notificationID,
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaTriggerS3_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_trigger_s3.test"
+	functionResourceName := "aws_lambda_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerS3Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerS3Config_basic(rName, "uploads/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerS3Exists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "uploads/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ".csv"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "notification_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "statement_id"),
+				),
+			},
+			{
+				Config: testAccTriggerS3Config_basic(rName, "incoming/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerS3Exists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "incoming/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaTriggerS3_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_trigger_s3.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerS3Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerS3Config_basic(rName, "uploads/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerS3Exists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceTriggerS3(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaTriggerS3_permissionDisappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_trigger_s3.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var notificationID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerS3Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerS3Config_basic(rName, "uploads/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerS3Exists(ctx, resourceName),
+					resource.TestCheckResourceAttrWith(resourceName, "notification_id", func(v string) error {
+						notificationID = v
+						return nil
+					}),
+					testAccCheckTriggerS3PermissionDisappears(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTriggerS3Config_basic(rName, "uploads/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerS3Exists(ctx, resourceName),
+					resource.TestCheckResourceAttrWith(resourceName, "notification_id", func(v string) error {
+						if v != notificationID {
+							return fmt.Errorf("notification_id changed from %s to %s", notificationID, v)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "statement_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTriggerS3Destroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := acctest.Provider.Meta().(*conns.AWSClient)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_trigger_s3" {
+				continue
+			}
+
+			_, err := tflambda.FindBucketLambdaFunctionConfigurationByTwoPartKey(ctx, c.S3Client(ctx), rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["notification_id"])
+
+			if err == nil {
+				return fmt.Errorf("Lambda S3 Trigger %s notification still exists", rs.Primary.ID)
+			}
+
+			if !tfresource.NotFound(err) {
+				return err
+			}
+
+			_, err = tflambda.FindPolicyStatementByTwoPartKey(ctx, c.LambdaClient(ctx), rs.Primary.Attributes["function_arn"], rs.Primary.Attributes["statement_id"], "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lambda S3 Trigger %s permission still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTriggerS3Exists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		c := acctest.Provider.Meta().(*conns.AWSClient)
+
+		_, err := tflambda.FindBucketLambdaFunctionConfigurationByTwoPartKey(ctx, c.S3Client(ctx), rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["notification_id"])
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflambda.FindPolicyStatementByTwoPartKey(ctx, c.LambdaClient(ctx), rs.Primary.Attributes["function_arn"], rs.Primary.Attributes["statement_id"], "")
+
+		return err
+	}
+}
+
+func testAccCheckTriggerS3PermissionDisappears(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		c := acctest.Provider.Meta().(*conns.AWSClient)
+
+		return tflambda.RemoveTriggerPermission(ctx, c.LambdaClient(ctx), rs.Primary.Attributes["function_arn"], rs.Primary.Attributes["statement_id"])
+	}
+}
+
+func testAccTriggerS3Config_basic(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_lambda_trigger_s3" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  function_arn  = aws_lambda_function.test.arn
+  events        = ["s3:ObjectCreated:*"]
+  filter_prefix = %[2]q
+  filter_suffix = ".csv"
+}
+`, rName, prefix))
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lambda_trigger_sns", name="SNS Trigger")
+func resourceTriggerSNS() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTriggerSNSCreate,
+		ReadWithoutTimeout:   resourceTriggerSNSRead,
+		UpdateWithoutTimeout: resourceTriggerSNSUpdate,
+		DeleteWithoutTimeout: resourceTriggerSNSDelete,
+
+		Schema: map[string]*schema.Schema{
+			"filter_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"statement_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTopicARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTriggerSNSCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.LambdaClient(ctx)
+
+	functionARN, topicARN := d.Get("function_arn").(string), d.Get(names.AttrTopicARN).(string)
+	statementID, err := addTriggerPermission(ctx, conn, functionARN, "sns.amazonaws.com", topicARN, "", "AllowExecutionFromSNS-")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding Lambda SNS Trigger (%s/%s) permission: %s", topicARN, functionARN, err)
+	}
+
+	input := sns.SubscribeInput{
+		Endpoint:              aws.String(functionARN),
+		Protocol:              aws.String("lambda"),
+		ReturnSubscriptionArn: true,
+		TopicArn:              aws.String(topicARN),
+	}
+
+	if v, ok := d.GetOk("filter_policy"); ok {
+		input.Attributes = map[string]string{
+			"FilterPolicy": v.(string),
+		}
+	}
+
+	output, err := c.SNSClient(ctx).Subscribe(ctx, &input)
+
+	if err != nil {
+		// Don't leave the permission behind without its subscription.
+		if err := removeTriggerPermission(ctx, conn, functionARN, statementID); err != nil {
+			log.Printf("[WARN] removing Lambda Permission (%s/%s): %s", functionARN, statementID, err)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "creating Lambda SNS Trigger (%s/%s) subscription: %s", topicARN, functionARN, err)
+	}
+
+	d.SetId(aws.ToString(output.SubscriptionArn))
+	d.Set("statement_id", statementID)
+
+	return append(diags, resourceTriggerSNSRead(ctx, d, meta)...)
+}
+
+func resourceTriggerSNSRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.LambdaClient(ctx)
+
+	attributes, err := findSNSSubscriptionAttributesByARN(ctx, c.SNSClient(ctx), d.Id())
+
+	if err == nil {
+		// Either side going missing breaks the trigger, so both are recreated.
+		_, err = tfresource.RetryWhenNewResourceNotFound(ctx, lambdaPropagationTimeout, func(ctx context.Context) (*policyStatement, error) {
+			return findPolicyStatementByTwoPartKey(ctx, conn, d.Get("function_arn").(string), d.Get("statement_id").(string), "")
+		}, d.IsNewResource())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda SNS Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda SNS Trigger (%s): %s", d.Id(), err)
+	}
+
+	d.Set("filter_policy", attributes["FilterPolicy"])
+	d.Set("function_arn", attributes["Endpoint"])
+	d.Set("subscription_arn", attributes["SubscriptionArn"])
+	d.Set(names.AttrTopicARN, attributes["TopicArn"])
+
+	return diags
+}
+
+func resourceTriggerSNSUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SNSClient(ctx)
+
+	if d.HasChange("filter_policy") {
+		input := sns.SetSubscriptionAttributesInput{
+			AttributeName:   aws.String("FilterPolicy"),
+			AttributeValue:  aws.String(d.Get("filter_policy").(string)),
+			SubscriptionArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.SetSubscriptionAttributes(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda SNS Trigger (%s) filter policy: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTriggerSNSRead(ctx, d, meta)...)
+}
+
+func resourceTriggerSNSDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+
+	log.Printf("[DEBUG] Deleting Lambda SNS Trigger: %s", d.Id())
+	input := sns.UnsubscribeInput{
+		SubscriptionArn: aws.String(d.Id()),
+	}
+	_, err := c.SNSClient(ctx).Unsubscribe(ctx, &input)
+
+	if err != nil && !errs.IsA[*snstypes.NotFoundException](err) {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda SNS Trigger (%s) subscription: %s", d.Id(), err)
+	}
+
+	if err := removeTriggerPermission(ctx, c.LambdaClient(ctx), d.Get("function_arn").(string), d.Get("statement_id").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda SNS Trigger (%s) permission: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSNSSubscriptionAttributesByARN(ctx context.Context, conn *sns.Client, arn string) (map[string]string, error) {
+	input := sns.GetSubscriptionAttributesInput{
+		SubscriptionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetSubscriptionAttributes(ctx, &input)
+
+	if errs.IsA[*snstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Attributes) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Attributes, nil
+}
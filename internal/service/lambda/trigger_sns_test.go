@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaTriggerSNS_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_trigger_sns.test"
+	functionResourceName := "aws_lambda_function.test"
+	topicResourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerSNSDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerSNSConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerSNSExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "statement_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "subscription_arn", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTopicARN, topicResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccTriggerSNSConfig_filterPolicy(rName, "orders"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerSNSExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy", `{"source":["orders"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaTriggerSNS_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_trigger_sns.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerSNSDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerSNSConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerSNSExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceTriggerSNS(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTriggerSNSDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := acctest.Provider.Meta().(*conns.AWSClient)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_trigger_sns" {
+				continue
+			}
+
+			_, err := tflambda.FindSNSSubscriptionAttributesByARN(ctx, c.SNSClient(ctx), rs.Primary.ID)
+
+			if err == nil {
+				return fmt.Errorf("Lambda SNS Trigger %s subscription still exists", rs.Primary.ID)
+			}
+
+			if !tfresource.NotFound(err) {
+				return err
+			}
+
+			_, err = tflambda.FindPolicyStatementByTwoPartKey(ctx, c.LambdaClient(ctx), rs.Primary.Attributes["function_arn"], rs.Primary.Attributes["statement_id"], "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lambda SNS Trigger %s permission still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTriggerSNSExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		c := acctest.Provider.Meta().(*conns.AWSClient)
+
+		_, err := tflambda.FindSNSSubscriptionAttributesByARN(ctx, c.SNSClient(ctx), rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflambda.FindPolicyStatementByTwoPartKey(ctx, c.LambdaClient(ctx), rs.Primary.Attributes["function_arn"], rs.Primary.Attributes["statement_id"], "")
+
+		return err
+	}
+}
+
+func testAccTriggerSNSConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccTriggerSNSConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTriggerSNSConfig_base(rName), `
+resource "aws_lambda_trigger_sns" "test" {
+  function_arn = aws_lambda_function.test.arn
+  topic_arn    = aws_sns_topic.test.arn
+}
+`)
+}
+
+func testAccTriggerSNSConfig_filterPolicy(rName, source string) string {
+	return acctest.ConfigCompose(testAccTriggerSNSConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_trigger_sns" "test" {
+  function_arn = aws_lambda_function.test.arn
+  topic_arn    = aws_sns_topic.test.arn
+
+  filter_policy = jsonencode({
+    source = [%[1]q]
+  })
+}
+`, source))
+}
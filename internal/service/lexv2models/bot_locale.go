@@ -86,6 +86,52 @@ func (r *botLocaleResource) Schema(ctx context.Context, request resource.SchemaR
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"generative_ai_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[generativeAISettingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"buildtime_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[buildtimeSettingsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"descriptive_bot_builder":     generativeAIFeatureSpecificationBlock(ctx),
+									"sample_utterance_generation": generativeAIFeatureSpecificationBlock(ctx),
+								},
+							},
+						},
+						"runtime_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[runtimeSettingsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"nlu_improvement": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[nluImprovementSpecificationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrEnabled: schema.BoolAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"slot_resolution_improvement": generativeAIFeatureSpecificationBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
 			"voice_settings": schema.ListNestedBlock{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[voiceSettingsModel](ctx),
 				Validators: []validator.List{
@@ -116,6 +162,67 @@ func (r *botLocaleResource) Schema(ctx context.Context, request resource.SchemaR
 	}
 }
 
+func generativeAIFeatureSpecificationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[generativeAIFeatureSpecificationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrEnabled: schema.BoolAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"bedrock_model_specification": bedrockModelSpecificationBlock(ctx),
+			},
+		},
+	}
+}
+
+func bedrockModelSpecificationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[bedrockModelSpecificationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"custom_prompt": schema.StringAttribute{
+					Optional: true,
+				},
+				"model_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"trace_status": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.BedrockTraceStatus](),
+					Optional:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"guardrail": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[bedrockGuardrailConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrIdentifier: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrVersion: schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 const (
 	botLocaleResourceIDPartCount = 3
 )
@@ -231,6 +338,7 @@ func (r *botLocaleResource) Update(ctx context.Context, request resource.UpdateR
 	if !new.BotID.Equal(old.BotID) ||
 		!new.BotVersion.Equal(old.BotVersion) ||
 		!new.Description.Equal(old.Description) ||
+		!new.GenerativeAISettings.Equal(old.GenerativeAISettings) ||
 		!new.LocaleID.Equal(old.LocaleID) ||
 		!new.LocaleName.Equal(old.LocaleName) ||
 		!new.NLUIntentConfidenceThreshold.Equal(old.NLUIntentConfidenceThreshold) ||
@@ -406,18 +514,55 @@ func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.Client, localeI
 
 type botLocaleResourceModel struct {
 	framework.WithRegionModel
-	BotID                        types.String                                        `tfsdk:"bot_id"`
-	BotVersion                   types.String                                        `tfsdk:"bot_version"`
-	Description                  types.String                                        `tfsdk:"description"`
-	ID                           types.String                                        `tfsdk:"id"`
-	LocaleID                     types.String                                        `tfsdk:"locale_id"`
-	LocaleName                   types.String                                        `tfsdk:"name"`
-	NLUIntentConfidenceThreshold types.Float64                                       `tfsdk:"n_lu_intent_confidence_threshold"`
-	Timeouts                     timeouts.Value                                      `tfsdk:"timeouts"`
-	VoiceSettings                fwtypes.ListNestedObjectValueOf[voiceSettingsModel] `tfsdk:"voice_settings"`
+	BotID                        types.String                                               `tfsdk:"bot_id"`
+	BotVersion                   types.String                                               `tfsdk:"bot_version"`
+	Description                  types.String                                               `tfsdk:"description"`
+	GenerativeAISettings         fwtypes.ListNestedObjectValueOf[generativeAISettingsModel] `tfsdk:"generative_ai_settings"`
+	ID                           types.String                                               `tfsdk:"id"`
+	LocaleID                     types.String                                               `tfsdk:"locale_id"`
+	LocaleName                   types.String                                               `tfsdk:"name"`
+	NLUIntentConfidenceThreshold types.Float64                                              `tfsdk:"n_lu_intent_confidence_threshold"`
+	Timeouts                     timeouts.Value                                             `tfsdk:"timeouts"`
+	VoiceSettings                fwtypes.ListNestedObjectValueOf[voiceSettingsModel]        `tfsdk:"voice_settings"`
 }
 
 type voiceSettingsModel struct {
 	Engine  fwtypes.StringEnum[awstypes.VoiceEngine] `tfsdk:"engine"`
 	VoiceID types.String                             `tfsdk:"voice_id"`
 }
+
+type generativeAISettingsModel struct {
+	BuildtimeSettings fwtypes.ListNestedObjectValueOf[buildtimeSettingsModel] `tfsdk:"buildtime_settings"`
+	RuntimeSettings   fwtypes.ListNestedObjectValueOf[runtimeSettingsModel]   `tfsdk:"runtime_settings"`
+}
+
+type buildtimeSettingsModel struct {
+	DescriptiveBotBuilder     fwtypes.ListNestedObjectValueOf[generativeAIFeatureSpecificationModel] `tfsdk:"descriptive_bot_builder"`
+	SampleUtteranceGeneration fwtypes.ListNestedObjectValueOf[generativeAIFeatureSpecificationModel] `tfsdk:"sample_utterance_generation"`
+}
+
+type runtimeSettingsModel struct {
+	NluImprovement            fwtypes.ListNestedObjectValueOf[nluImprovementSpecificationModel]      `tfsdk:"nlu_improvement"`
+	SlotResolutionImprovement fwtypes.ListNestedObjectValueOf[generativeAIFeatureSpecificationModel] `tfsdk:"slot_resolution_improvement"`
+}
+
+type nluImprovementSpecificationModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+type generativeAIFeatureSpecificationModel struct {
+	BedrockModelSpecification fwtypes.ListNestedObjectValueOf[bedrockModelSpecificationModel] `tfsdk:"bedrock_model_specification"`
+	Enabled                   types.Bool                                                      `tfsdk:"enabled"`
+}
+
+type bedrockModelSpecificationModel struct {
+	CustomPrompt types.String                                                        `tfsdk:"custom_prompt"`
+	Guardrail    fwtypes.ListNestedObjectValueOf[bedrockGuardrailConfigurationModel] `tfsdk:"guardrail"`
+	ModelARN     fwtypes.ARN                                                         `tfsdk:"model_arn"`
+	TraceStatus  fwtypes.StringEnum[awstypes.BedrockTraceStatus]                     `tfsdk:"trace_status"`
+}
+
+type bedrockGuardrailConfigurationModel struct {
+	Identifier types.String `tfsdk:"identifier"`
+	Version    types.String `tfsdk:"version"`
+}
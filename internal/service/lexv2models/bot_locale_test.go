@@ -121,6 +121,49 @@ func TestAccLexV2ModelsBotLocale_voiceSettings(t *testing.T) {
 	})
 }
 
+func TestAccLexV2ModelsBotLocale_generativeAISettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var botlocale lexmodelsv2.DescribeBotLocaleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleConfig_generativeAISettings(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.descriptive_bot_builder.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.runtime_settings.0.slot_resolution_improvement.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "generative_ai_settings.0.runtime_settings.0.slot_resolution_improvement.0.bedrock_model_specification.0.model_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBotLocaleConfig_generativeAISettings(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.descriptive_bot_builder.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.runtime_settings.0.slot_resolution_improvement.0.enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBotLocaleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
@@ -213,3 +256,40 @@ resource "aws_lexv2models_bot_locale" "test" {
 }
 `, voiceID, engine))
 }
+
+func testAccBotLocaleConfig_generativeAISettings(rName string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_base(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  n_lu_intent_confidence_threshold = 0.7
+
+  generative_ai_settings {
+    buildtime_settings {
+      descriptive_bot_builder {
+        enabled = %[1]t
+
+        bedrock_model_specification {
+          model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.region}::foundation-model/anthropic.claude-3-haiku-20240307-v1:0"
+        }
+      }
+    }
+
+    runtime_settings {
+      slot_resolution_improvement {
+        enabled = %[1]t
+
+        bedrock_model_specification {
+          model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.region}::foundation-model/anthropic.claude-3-haiku-20240307-v1:0"
+        }
+      }
+    }
+  }
+}
+`, enabled))
+}
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lexv2models_bot_replica", name="Bot Replica")
+func newBotReplicaResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &botReplicaResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type botReplicaResource struct {
+	framework.ResourceWithModel[botReplicaResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+	framework.WithNoUpdate
+}
+
+func (r *botReplicaResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_replica_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BotReplicaStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"replica_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+const (
+	botReplicaResourceIDPartCount = 2
+)
+
+func (r *botReplicaResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data botReplicaResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var input lexmodelsv2.CreateBotReplicaInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateBotReplica(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Lex v2 Bot Replica", err.Error())
+
+		return
+	}
+
+	botID, replicaRegion := aws.ToString(output.BotId), aws.ToString(output.ReplicaRegion)
+	id, _ := intflex.FlattenResourceId([]string{botID, replicaRegion}, botReplicaResourceIDPartCount, false)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	replica, err := waitBotReplicaCreated(ctx, conn, botID, replicaRegion, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Bot Replica (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, replica, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *botReplicaResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data botReplicaResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	parts, err := intflex.ExpandResourceId(id, botReplicaResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	botID, replicaRegion := parts[0], parts[1]
+	output, err := findBotReplicaByTwoPartKey(ctx, conn, botID, replicaRegion)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lex v2 Bot Replica (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *botReplicaResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data botReplicaResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	parts, err := intflex.ExpandResourceId(id, botReplicaResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	botID, replicaRegion := parts[0], parts[1]
+	input := lexmodelsv2.DeleteBotReplicaInput{
+		BotId:         aws.String(botID),
+		ReplicaRegion: aws.String(replicaRegion),
+	}
+	_, err = conn.DeleteBotReplica(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lex v2 Bot Replica (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitBotReplicaDeleted(ctx, conn, botID, replicaRegion, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Bot Replica (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func findBotReplicaByTwoPartKey(ctx context.Context, conn *lexmodelsv2.Client, botID, replicaRegion string) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	input := lexmodelsv2.DescribeBotReplicaInput{
+		BotId:         aws.String(botID),
+		ReplicaRegion: aws.String(replicaRegion),
+	}
+	output, err := conn.DescribeBotReplica(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BotId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusBotReplica(ctx context.Context, conn *lexmodelsv2.Client, botID, replicaRegion string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBotReplicaByTwoPartKey(ctx, conn, botID, replicaRegion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BotReplicaStatus), nil
+	}
+}
+
+func waitBotReplicaCreated(ctx context.Context, conn *lexmodelsv2.Client, botID, replicaRegion string, timeout time.Duration) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.BotReplicaStatusEnabling),
+		Target:     enum.Slice(awstypes.BotReplicaStatusEnabled),
+		Refresh:    statusBotReplica(ctx, conn, botID, replicaRegion),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotReplicaOutput); ok {
+		tfresource.SetLastError(err, botFailureReasons(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotReplicaDeleted(ctx context.Context, conn *lexmodelsv2.Client, botID, replicaRegion string, timeout time.Duration) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BotReplicaStatusDeleting, awstypes.BotReplicaStatusEnabled),
+		Target:  []string{},
+		Refresh: statusBotReplica(ctx, conn, botID, replicaRegion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotReplicaOutput); ok {
+		tfresource.SetLastError(err, botFailureReasons(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type botReplicaResourceModel struct {
+	framework.WithRegionModel
+	BotID            types.String                                  `tfsdk:"bot_id"`
+	BotReplicaStatus fwtypes.StringEnum[awstypes.BotReplicaStatus] `tfsdk:"bot_replica_status"`
+	CreationDateTime timetypes.RFC3339                             `tfsdk:"creation_date_time"`
+	ID               types.String                                  `tfsdk:"id"`
+	ReplicaRegion    types.String                                  `tfsdk:"replica_region"`
+	SourceRegion     types.String                                  `tfsdk:"source_region"`
+	Timeouts         timeouts.Value                                `tfsdk:"timeouts"`
+}
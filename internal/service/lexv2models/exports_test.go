@@ -5,17 +5,23 @@ package lexv2models
 
 // Exports for use in tests only.
 var (
-	ResourceBot        = newBotResource
-	ResourceBotLocale  = newBotLocaleResource
-	ResourceBotVersion = newBotVersionResource
-	ResourceIntent     = newIntentResource
-	ResourceSlot       = newSlotResource
-	ResourceSlotType   = newSlotTypeResource
+	ResourceBot           = newBotResource
+	ResourceBotLocale     = newBotLocaleResource
+	ResourceBotReplica    = newBotReplicaResource
+	ResourceBotVersion    = newBotVersionResource
+	ResourceIntent        = newIntentResource
+	ResourceSlot          = newSlotResource
+	ResourceSlotType      = newSlotTypeResource
+	ResourceTestExecution = newTestExecutionResource
+	ResourceTestSet       = newTestSetResource
 
 	FindBotByID                 = findBotByID
 	FindBotLocaleByThreePartKey = findBotLocaleByThreePartKey
+	FindBotReplicaByTwoPartKey  = findBotReplicaByTwoPartKey
 	FindBotVersionByTwoPartKey  = findBotVersionByTwoPartKey
 	FindSlotByID                = findSlotByID
+	FindTestExecutionByID       = findTestExecutionByID
+	FindTestSetByID             = findTestSetByID
 
 	IntentFlexOpt = intentFlexOpt
 
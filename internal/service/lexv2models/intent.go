@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -123,6 +124,131 @@ func (r *intentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		},
 	}
 
+	qnaIntentConfigurationLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[QnAIntentConfiguration](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"bedrock_model_configuration": bedrockModelSpecificationBlock(ctx),
+				"data_source_configuration": schema.ListNestedBlock{
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					CustomType: fwtypes.NewListNestedObjectTypeOf[DataSourceConfiguration](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"bedrock_knowledge_store_configuration": schema.ListNestedBlock{
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								CustomType: fwtypes.NewListNestedObjectTypeOf[BedrockKnowledgeStoreConfiguration](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"bedrock_knowledge_base_arn": schema.StringAttribute{
+											Required: true,
+										},
+										"exact_response": schema.BoolAttribute{
+											Optional: true,
+											Computed: true,
+											Default:  booldefault.StaticBool(false),
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"exact_response_fields": schema.ListNestedBlock{
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											CustomType: fwtypes.NewListNestedObjectTypeOf[BedrockKnowledgeStoreExactResponseFields](ctx),
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"answer_field": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"kendra_configuration": schema.ListNestedBlock{
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								CustomType: fwtypes.NewListNestedObjectTypeOf[QnAKendraConfiguration](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"exact_response": schema.BoolAttribute{
+											Optional: true,
+											Computed: true,
+											Default:  booldefault.StaticBool(false),
+										},
+										"kendra_index": schema.StringAttribute{
+											Required: true,
+										},
+										"query_filter_string": schema.StringAttribute{
+											Optional: true,
+										},
+										"query_filter_string_enabled": schema.BoolAttribute{
+											Optional: true,
+											Computed: true,
+											Default:  booldefault.StaticBool(false),
+										},
+									},
+								},
+							},
+							"opensearch_configuration": schema.ListNestedBlock{
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								CustomType: fwtypes.NewListNestedObjectTypeOf[OpensearchConfiguration](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"domain_endpoint": schema.StringAttribute{
+											Required: true,
+										},
+										"exact_response": schema.BoolAttribute{
+											Optional: true,
+											Computed: true,
+											Default:  booldefault.StaticBool(false),
+										},
+										"include_fields": schema.ListAttribute{
+											CustomType:  fwtypes.ListOfStringType,
+											ElementType: types.StringType,
+											Optional:    true,
+										},
+										"index_name": schema.StringAttribute{
+											Required: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"exact_response_fields": schema.ListNestedBlock{
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											CustomType: fwtypes.NewListNestedObjectTypeOf[ExactResponseFields](ctx),
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"answer_field": schema.StringAttribute{
+														Optional: true,
+													},
+													"question_field": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	customPayloadLNB := schema.ListNestedBlock{
 		Validators: []validator.List{
 			listvalidator.SizeAtMost(1),
@@ -884,6 +1010,7 @@ func (r *intentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"confirmation_setting":     confirmationSettingLNB,
 			"kendra_configuration":     kendraConfigurationLNB,
 			"output_context":           outputContextLNB,
+			"qna_intent_configuration": qnaIntentConfigurationLNB,
 			"sample_utterance":         sampleUtteranceLNB,
 			"slot_priority":            slotPriorityLNB,
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
@@ -1039,6 +1166,9 @@ func (r *intentResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !new.ParentIntentSignature.Equal(old.ParentIntentSignature) {
 		change = true
 	}
+	if !new.QnAIntentConfiguration.Equal(old.QnAIntentConfiguration) {
+		change = true
+	}
 	if !new.SampleUtterance.Equal(old.SampleUtterance) {
 		change = true
 	}
@@ -1271,6 +1401,47 @@ type KendraConfiguration struct {
 	QueryFilterStringEnabled types.Bool   `tfsdk:"query_filter_string_enabled"`
 }
 
+type QnAIntentConfiguration struct {
+	BedrockModelConfiguration fwtypes.ListNestedObjectValueOf[bedrockModelSpecificationModel] `tfsdk:"bedrock_model_configuration"`
+	DataSourceConfiguration   fwtypes.ListNestedObjectValueOf[DataSourceConfiguration]        `tfsdk:"data_source_configuration"`
+}
+
+type DataSourceConfiguration struct {
+	BedrockKnowledgeStoreConfiguration fwtypes.ListNestedObjectValueOf[BedrockKnowledgeStoreConfiguration] `tfsdk:"bedrock_knowledge_store_configuration"`
+	KendraConfiguration                fwtypes.ListNestedObjectValueOf[QnAKendraConfiguration]             `tfsdk:"kendra_configuration"`
+	OpensearchConfiguration            fwtypes.ListNestedObjectValueOf[OpensearchConfiguration]            `tfsdk:"opensearch_configuration"`
+}
+
+type BedrockKnowledgeStoreConfiguration struct {
+	BedrockKnowledgeBaseArn types.String                                                              `tfsdk:"bedrock_knowledge_base_arn"`
+	ExactResponse           types.Bool                                                                `tfsdk:"exact_response"`
+	ExactResponseFields     fwtypes.ListNestedObjectValueOf[BedrockKnowledgeStoreExactResponseFields] `tfsdk:"exact_response_fields"`
+}
+
+type BedrockKnowledgeStoreExactResponseFields struct {
+	AnswerField types.String `tfsdk:"answer_field"`
+}
+
+type QnAKendraConfiguration struct {
+	ExactResponse            types.Bool   `tfsdk:"exact_response"`
+	KendraIndex              types.String `tfsdk:"kendra_index"`
+	QueryFilterString        types.String `tfsdk:"query_filter_string"`
+	QueryFilterStringEnabled types.Bool   `tfsdk:"query_filter_string_enabled"`
+}
+
+type OpensearchConfiguration struct {
+	DomainEndpoint      types.String                                         `tfsdk:"domain_endpoint"`
+	ExactResponse       types.Bool                                           `tfsdk:"exact_response"`
+	ExactResponseFields fwtypes.ListNestedObjectValueOf[ExactResponseFields] `tfsdk:"exact_response_fields"`
+	IncludeFields       fwtypes.ListOfString                                 `tfsdk:"include_fields"`
+	IndexName           types.String                                         `tfsdk:"index_name"`
+}
+
+type ExactResponseFields struct {
+	AnswerField   types.String `tfsdk:"answer_field"`
+	QuestionField types.String `tfsdk:"question_field"`
+}
+
 type CustomPayload struct {
 	Value types.String `tfsdk:"value"`
 }
@@ -1513,6 +1684,7 @@ type IntentResourceModel struct {
 	Name                   types.String                                                 `tfsdk:"name"`
 	OutputContext          fwtypes.ListNestedObjectValueOf[OutputContext]               `tfsdk:"output_context"`
 	ParentIntentSignature  types.String                                                 `tfsdk:"parent_intent_signature"`
+	QnAIntentConfiguration fwtypes.ListNestedObjectValueOf[QnAIntentConfiguration]      `tfsdk:"qna_intent_configuration"`
 	SampleUtterance        fwtypes.ListNestedObjectValueOf[SampleUtterance]             `tfsdk:"sample_utterance"`
 	SlotPriority           fwtypes.ListNestedObjectValueOf[SlotPriority]                `tfsdk:"slot_priority"`
 	Timeouts               timeouts.Value                                               `tfsdk:"timeouts"`
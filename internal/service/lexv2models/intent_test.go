@@ -1861,6 +1861,51 @@ func TestAccLexV2ModelsIntent_updateSampleUtterance(t *testing.T) {
 	})
 }
 
+func TestAccLexV2ModelsIntent_qnaIntentConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var intent lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+	knowledgeBaseARN := acctest.SkipIfEnvVarNotSet(t, "LEXV2_BEDROCK_KNOWLEDGE_BASE_ARN")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig_qnaIntentConfiguration(rName, knowledgeBaseARN, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(ctx, resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "parent_intent_signature", "AMAZON.QnAIntent"),
+					resource.TestCheckResourceAttr(resourceName, "qna_intent_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "qna_intent_configuration.0.bedrock_model_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "qna_intent_configuration.0.data_source_configuration.0.bedrock_knowledge_store_configuration.0.bedrock_knowledge_base_arn", knowledgeBaseARN),
+					resource.TestCheckResourceAttr(resourceName, "qna_intent_configuration.0.data_source_configuration.0.bedrock_knowledge_store_configuration.0.exact_response", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntentConfig_qnaIntentConfiguration(rName, knowledgeBaseARN, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(ctx, resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "qna_intent_configuration.0.data_source_configuration.0.bedrock_knowledge_store_configuration.0.exact_response", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIntentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
@@ -2579,3 +2624,32 @@ resource "aws_lexv2models_intent" "test" {
 }
 `, rName, utter1, utter2, utter3, utter4))
 }
+
+func testAccIntentConfig_qnaIntentConfiguration(rName, knowledgeBaseARN string, exactResponse bool) string {
+	return acctest.ConfigCompose(
+		testAccIntentConfig_base(rName, 60, true),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_lexv2models_intent" "test" {
+  bot_id                  = aws_lexv2models_bot.test.id
+  bot_version             = aws_lexv2models_bot_locale.test.bot_version
+  name                    = %[1]q
+  locale_id               = aws_lexv2models_bot_locale.test.locale_id
+  parent_intent_signature = "AMAZON.QnAIntent"
+
+  qna_intent_configuration {
+    bedrock_model_configuration {
+      model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.region}::foundation-model/anthropic.claude-3-haiku-20240307-v1:0"
+    }
+
+    data_source_configuration {
+      bedrock_knowledge_store_configuration {
+        bedrock_knowledge_base_arn = %[2]q
+        exact_response             = %[3]t
+      }
+    }
+  }
+}
+`, rName, knowledgeBaseARN, exactResponse))
+}
@@ -38,6 +38,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Bot Locale",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newBotReplicaResource,
+			TypeName: "aws_lexv2models_bot_replica",
+			Name:     "Bot Replica",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newBotVersionResource,
 			TypeName: "aws_lexv2models_bot_version",
@@ -62,6 +68,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Slot Type",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newTestExecutionResource,
+			TypeName: "aws_lexv2models_test_execution",
+			Name:     "Test Execution",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newTestSetResource,
+			TypeName: "aws_lexv2models_test_set",
+			Name:     "Test Set",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
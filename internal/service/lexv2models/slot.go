@@ -865,5 +865,6 @@ type ValueElicitationSettingData struct {
 func slotHasChanges(_ context.Context, plan, state slotResourceModel) bool {
 	return !plan.Description.Equal(state.Description) ||
 		!plan.MultipleValuesSetting.Equal(state.MultipleValuesSetting) ||
-		!plan.SlotTypeID.Equal(state.SlotTypeID)
+		!plan.SlotTypeID.Equal(state.SlotTypeID) ||
+		!plan.ValueElicitationSetting.Equal(state.ValueElicitationSetting)
 }
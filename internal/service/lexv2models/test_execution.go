@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lexv2models_test_execution", name="Test Execution")
+func newTestExecutionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &testExecutionResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type testExecutionResource struct {
+	framework.ResourceWithModel[testExecutionResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+	framework.WithNoUpdate
+}
+
+func (r *testExecutionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestExecutionApiMode](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"test_execution_modality": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestExecutionModality](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_execution_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestExecutionStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_set_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"test_set_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTarget: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testExecutionTargetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bot_alias_target": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[botAliasTestExecutionTargetModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_alias_id": schema.StringAttribute{
+										Required: true,
+									},
+									"bot_id": schema.StringAttribute{
+										Required: true,
+									},
+									"locale_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *testExecutionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data testExecutionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var input lexmodelsv2.StartTestExecutionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartTestExecution(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Lex v2 Test Execution", err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.TestExecutionId)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	testExecution, err := waitTestExecutionCompleted(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Test Execution (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreationDateTime = fwflex.TimeToFramework(ctx, testExecution.CreationDateTime)
+	data.TestExecutionModality = fwtypes.StringEnumValue(testExecution.TestExecutionModality)
+	data.TestExecutionStatus = fwtypes.StringEnumValue(testExecution.TestExecutionStatus)
+	data.TestSetName = fwflex.StringToFramework(ctx, testExecution.TestSetName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *testExecutionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data testExecutionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findTestExecutionByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lex v2 Test Execution (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *testExecutionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Test executions cannot be deleted. Their results are retained by Amazon Lex.
+}
+
+func findTestExecutionByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeTestExecutionOutput, error) {
+	input := lexmodelsv2.DescribeTestExecutionInput{
+		TestExecutionId: aws.String(id),
+	}
+	output, err := conn.DescribeTestExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TestExecutionId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTestExecution(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findTestExecutionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.TestExecutionStatus), nil
+	}
+}
+
+func waitTestExecutionCompleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.TestExecutionStatusPending, awstypes.TestExecutionStatusWaiting, awstypes.TestExecutionStatusInProgress),
+		Target:     enum.Slice(awstypes.TestExecutionStatusCompleted),
+		Refresh:    statusTestExecution(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeTestExecutionOutput); ok {
+		tfresource.SetLastError(err, botFailureReasons(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type testExecutionResourceModel struct {
+	framework.WithRegionModel
+	APIMode               fwtypes.StringEnum[awstypes.TestExecutionApiMode]         `tfsdk:"api_mode"`
+	CreationDateTime      timetypes.RFC3339                                         `tfsdk:"creation_date_time"`
+	ID                    types.String                                              `tfsdk:"id"`
+	Target                fwtypes.ListNestedObjectValueOf[testExecutionTargetModel] `tfsdk:"target"`
+	TestExecutionModality fwtypes.StringEnum[awstypes.TestExecutionModality]        `tfsdk:"test_execution_modality"`
+	TestExecutionStatus   fwtypes.StringEnum[awstypes.TestExecutionStatus]          `tfsdk:"test_execution_status"`
+	TestSetID             types.String                                              `tfsdk:"test_set_id"`
+	TestSetName           types.String                                              `tfsdk:"test_set_name"`
+	Timeouts              timeouts.Value                                            `tfsdk:"timeouts"`
+}
+
+type testExecutionTargetModel struct {
+	BotAliasTarget fwtypes.ListNestedObjectValueOf[botAliasTestExecutionTargetModel] `tfsdk:"bot_alias_target"`
+}
+
+type botAliasTestExecutionTargetModel struct {
+	BotAliasID types.String `tfsdk:"bot_alias_id"`
+	BotID      types.String `tfsdk:"bot_id"`
+	LocaleID   types.String `tfsdk:"locale_id"`
+}
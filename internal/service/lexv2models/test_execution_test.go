@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsTestExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var testexecution lexmodelsv2.DescribeTestExecutionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_test_execution.test"
+	testSetResourceName := "aws_lexv2models_test_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestExecutionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExecutionExists(ctx, resourceName, &testexecution),
+					resource.TestCheckResourceAttr(resourceName, "api_mode", "NonStreaming"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "test_execution_status", "Completed"),
+					resource.TestCheckResourceAttrPair(resourceName, "test_set_id", testSetResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "test_set_name", testSetResourceName, names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTestExecutionExists(ctx context.Context, n string, v *lexmodelsv2.DescribeTestExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		output, err := tflexv2models.FindTestExecutionByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTestExecutionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTestSetConfig_basic(rName, "test"),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 60
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = "true"
+  }
+}
+
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  n_lu_intent_confidence_threshold = 0.7
+}
+
+resource "aws_lexv2models_test_execution" "test" {
+  api_mode    = "NonStreaming"
+  test_set_id = aws_lexv2models_test_set.test.id
+
+  target {
+    bot_alias_target {
+      # Every bot has a built-in test alias that points at the DRAFT version.
+      bot_alias_id = "TSTALIASID"
+      bot_id       = aws_lexv2models_bot.test.id
+      locale_id    = aws_lexv2models_bot_locale.test.locale_id
+    }
+  }
+}
+`, rName))
+}
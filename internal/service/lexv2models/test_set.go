@@ -0,0 +1,491 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lexv2models_test_set", name="Test Set")
+func newTestSetResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &testSetResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type testSetResource struct {
+	framework.ResourceWithModel[testSetResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *testSetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"modality": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestSetModality](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"num_turns": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestSetStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"import_input_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testSetImportInputLocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrS3BucketName: schema.StringAttribute{
+							Required: true,
+						},
+						"s3_path": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"storage_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testSetStorageLocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						names.AttrS3BucketName: schema.StringAttribute{
+							Required: true,
+						},
+						"s3_path": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *testSetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data testSetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var specification awstypes.TestSetImportResourceSpecification
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &specification)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Test sets are imported from S3, but StartImport still requires an import ID.
+	uploadURL, err := conn.CreateUploadUrl(ctx, &lexmodelsv2.CreateUploadUrlInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Lex v2 Test Set upload URL", err.Error())
+
+		return
+	}
+
+	input := lexmodelsv2.StartImportInput{
+		ImportId:      uploadURL.ImportId,
+		MergeStrategy: awstypes.MergeStrategyFailOnConflict,
+		ResourceSpecification: &awstypes.ImportResourceSpecification{
+			TestSetImportResourceSpecification: &specification,
+		},
+	}
+	output, err := conn.StartImport(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Lex v2 Test Set", err.Error())
+
+		return
+	}
+
+	importID := aws.ToString(output.ImportId)
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	imp, err := waitImportCompleted(ctx, conn, importID, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Test Set import (%s) complete", importID), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(imp.ImportedResourceId)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	testSet, err := waitTestSetCreated(ctx, conn, id, timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Test Set (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreationDateTime = fwflex.TimeToFramework(ctx, testSet.CreationDateTime)
+	data.LastUpdatedDateTime = fwflex.TimeToFramework(ctx, testSet.LastUpdatedDateTime)
+	data.NumTurns = fwflex.Int32ToFrameworkInt64(ctx, testSet.NumTurns)
+	data.Status = fwtypes.StringEnumValue(testSet.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *testSetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data testSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findTestSetByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lex v2 Test Set (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *testSetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old testSetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ID)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.TestSetName.Equal(old.TestSetName) {
+		input := lexmodelsv2.UpdateTestSetInput{
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			TestSetId:   aws.String(id),
+			TestSetName: fwflex.StringFromFramework(ctx, new.TestSetName),
+		}
+		output, err := conn.UpdateTestSet(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lex v2 Test Set (%s)", id), err.Error())
+
+			return
+		}
+
+		new.LastUpdatedDateTime = fwflex.TimeToFramework(ctx, output.LastUpdatedDateTime)
+	} else {
+		new.LastUpdatedDateTime = old.LastUpdatedDateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *testSetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data testSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := lexmodelsv2.DeleteTestSetInput{
+		TestSetId: aws.String(id),
+	}
+	_, err := conn.DeleteTestSet(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lex v2 Test Set (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitTestSetDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Test Set (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func findTestSetByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	input := lexmodelsv2.DescribeTestSetInput{
+		TestSetId: aws.String(id),
+	}
+	output, err := conn.DescribeTestSet(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TestSetId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTestSet(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findTestSetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTestSetCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.TestSetStatusImporting),
+		Target:     enum.Slice(awstypes.TestSetStatusReady, awstypes.TestSetStatusPendingAnnotation),
+		Refresh:    statusTestSet(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeTestSetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTestSetDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TestSetStatusDeleting),
+		Target:  []string{},
+		Refresh: statusTestSet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeTestSetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func findImportByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeImportOutput, error) {
+	input := lexmodelsv2.DescribeImportInput{
+		ImportId: aws.String(id),
+	}
+	output, err := conn.DescribeImport(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImportId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusImport(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findImportByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ImportStatus), nil
+	}
+}
+
+func waitImportCompleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeImportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ImportStatusInProgress),
+		Target:     enum.Slice(awstypes.ImportStatusCompleted),
+		Refresh:    statusImport(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeImportOutput); ok {
+		tfresource.SetLastError(err, botFailureReasons(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type testSetResourceModel struct {
+	framework.WithRegionModel
+	CreationDateTime    timetypes.RFC3339                                                `tfsdk:"creation_date_time"`
+	Description         types.String                                                     `tfsdk:"description"`
+	ID                  types.String                                                     `tfsdk:"id"`
+	ImportInputLocation fwtypes.ListNestedObjectValueOf[testSetImportInputLocationModel] `tfsdk:"import_input_location"`
+	LastUpdatedDateTime timetypes.RFC3339                                                `tfsdk:"last_updated_date_time"`
+	Modality            fwtypes.StringEnum[awstypes.TestSetModality]                     `tfsdk:"modality"`
+	NumTurns            types.Int64                                                      `tfsdk:"num_turns"`
+	RoleARN             fwtypes.ARN                                                      `tfsdk:"role_arn"`
+	Status              fwtypes.StringEnum[awstypes.TestSetStatus]                       `tfsdk:"status"`
+	StorageLocation     fwtypes.ListNestedObjectValueOf[testSetStorageLocationModel]     `tfsdk:"storage_location"`
+	TestSetName         types.String                                                     `tfsdk:"name"`
+	Timeouts            timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type testSetImportInputLocationModel struct {
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	S3Path       types.String `tfsdk:"s3_path"`
+}
+
+type testSetStorageLocationModel struct {
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	S3Path       types.String `tfsdk:"s3_path"`
+}
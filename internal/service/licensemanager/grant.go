@@ -80,7 +80,7 @@ func resourceGrant() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
-				Description:  "The grantee principal ARN. The target account for the grant in the form of the ARN for an account principal of the root user.",
+				Description:  "The grantee principal ARN. An account principal of the root user, an AWS Organization, or an organizational unit.",
 			},
 			names.AttrStatus: {
 				Type:        schema.TypeString,
@@ -72,6 +72,10 @@ func dataSourceCluster() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"multi_region_cluster_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				names.AttrName: {
 					Type:     schema.TypeString,
 					Required: true,
@@ -205,6 +209,7 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set(names.AttrEngineVersion, cluster.EngineVersion)
 	d.Set(names.AttrKMSKeyARN, cluster.KmsKeyId) // KmsKeyId is actually an ARN here.
 	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("multi_region_cluster_name", cluster.MultiRegionClusterName)
 	d.Set(names.AttrName, cluster.Name)
 	d.Set("node_type", cluster.NodeType)
 	if v, err := deriveClusterNumReplicasPerShard(cluster); err != nil {
@@ -277,6 +277,16 @@ func TestAccMemoryDBCluster_multiRegionClusterName(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "multi_region_cluster_name", multiRegionClusterResourceName, "multi_region_cluster_name"),
 				),
 			},
+			{
+				// The multi-region cluster's member list is only updated on refresh once the regional cluster has joined.
+				Config: testAccClusterConfig_multiRegionClusterName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(multiRegionClusterResourceName, "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair(multiRegionClusterResourceName, "clusters.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(multiRegionClusterResourceName, "clusters.0.cluster_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(multiRegionClusterResourceName, "clusters.0.region", acctest.Region()),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
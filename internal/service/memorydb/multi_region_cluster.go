@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -28,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -63,6 +65,7 @@ func (r *multiRegionClusterResource) Schema(ctx context.Context, request resourc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"clusters": framework.ResourceComputedListOfObjectsAttribute[regionalClusterModel](ctx, listplanmodifier.UseStateForUnknown()),
 			names.AttrDescription: schema.StringAttribute{
 				Optional: true,
 			},
@@ -421,21 +424,29 @@ func (r *multiRegionClusterResource) ImportState(ctx context.Context, request re
 
 type multiRegionClusterResourceModel struct {
 	framework.WithRegionModel
-	ARN                           types.String   `tfsdk:"arn"`
-	Description                   types.String   `tfsdk:"description"`
-	Engine                        types.String   `tfsdk:"engine"`
-	EngineVersion                 types.String   `tfsdk:"engine_version"`
-	MultiRegionClusterName        types.String   `tfsdk:"multi_region_cluster_name"`
-	MultiRegionClusterNameSuffix  types.String   `tfsdk:"multi_region_cluster_name_suffix"`
-	MultiRegionParameterGroupName types.String   `tfsdk:"multi_region_parameter_group_name"`
-	NodeType                      types.String   `tfsdk:"node_type"`
-	NumShards                     types.Int64    `tfsdk:"num_shards"`
-	Status                        types.String   `tfsdk:"status"`
-	Tags                          tftags.Map     `tfsdk:"tags"`
-	TagsAll                       tftags.Map     `tfsdk:"tags_all"`
-	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
-	TLSEnabled                    types.Bool     `tfsdk:"tls_enabled"`
-	UpdateStrategy                types.String   `tfsdk:"update_strategy"`
+	ARN                           types.String                                          `tfsdk:"arn"`
+	Clusters                      fwtypes.ListNestedObjectValueOf[regionalClusterModel] `tfsdk:"clusters"`
+	Description                   types.String                                          `tfsdk:"description"`
+	Engine                        types.String                                          `tfsdk:"engine"`
+	EngineVersion                 types.String                                          `tfsdk:"engine_version"`
+	MultiRegionClusterName        types.String                                          `tfsdk:"multi_region_cluster_name"`
+	MultiRegionClusterNameSuffix  types.String                                          `tfsdk:"multi_region_cluster_name_suffix"`
+	MultiRegionParameterGroupName types.String                                          `tfsdk:"multi_region_parameter_group_name"`
+	NodeType                      types.String                                          `tfsdk:"node_type"`
+	NumShards                     types.Int64                                           `tfsdk:"num_shards"`
+	Status                        types.String                                          `tfsdk:"status"`
+	Tags                          tftags.Map                                            `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                            `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                        `tfsdk:"timeouts"`
+	TLSEnabled                    types.Bool                                            `tfsdk:"tls_enabled"`
+	UpdateStrategy                types.String                                          `tfsdk:"update_strategy"`
+}
+
+type regionalClusterModel struct {
+	ARN         types.String `tfsdk:"arn"`
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Region      types.String `tfsdk:"region"`
+	Status      types.String `tfsdk:"status"`
 }
 
 func findMultiRegionClusterByName(ctx context.Context, conn *memorydb.Client, name string) (*awstypes.MultiRegionCluster, error) {
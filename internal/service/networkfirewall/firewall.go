@@ -5,6 +5,7 @@ package networkfirewall
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -145,6 +146,14 @@ func resourceFirewall() *schema.Resource {
 											Type:     schema.TypeString,
 											Computed: true,
 										},
+										names.AttrStatusMessage: {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"transit_gateway_attachment_status": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
 									},
 								},
 							},
@@ -684,6 +693,10 @@ func waitFirewallTransitGatewayAttachmentCreated(ctx context.Context, conn *netw
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
+		if v := output.FirewallStatus.TransitGatewayAttachmentSyncState; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.StatusMessage)))
+		}
+
 		return output, err
 	}
 
@@ -892,7 +905,9 @@ func flattenTransitGatewayAttachmentSyncState(apiObject *awstypes.TransitGateway
 	}
 
 	tfMap := map[string]any{
-		"attachment_id": apiObject.AttachmentId,
+		"attachment_id":                     aws.ToString(apiObject.AttachmentId),
+		names.AttrStatusMessage:             aws.ToString(apiObject.StatusMessage),
+		"transit_gateway_attachment_status": apiObject.TransitGatewayAttachmentStatus,
 	}
 
 	return []any{tfMap}
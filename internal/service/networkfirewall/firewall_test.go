@@ -468,6 +468,9 @@ func TestAccNetworkFirewallFirewall_transitGatewayAttachment_basic(t *testing.T)
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFirewallExists(ctx, resourceName),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, names.AttrTransitGatewayID, transitGatewayResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.transit_gateway_attachment_sync_states.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "firewall_status.0.transit_gateway_attachment_sync_states.0.attachment_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "firewall_status.0.transit_gateway_attachment_sync_states.0.transit_gateway_attachment_status"),
 				),
 			},
 			{
@@ -41,6 +41,11 @@ func TestAccObservabilityAccessManager_serial(t *testing.T) {
 			acctest.CtBasic: testAccObservabilityAccessManagerSinkPolicy_basic,
 			"update":        testAccObservabilityAccessManagerSinkPolicy_update,
 		},
+		"SinkPolicyDocumentDataSource": {
+			acctest.CtBasic:  testAccObservabilityAccessManagerSinkPolicyDocumentDataSource_basic,
+			"organization":   testAccObservabilityAccessManagerSinkPolicyDocumentDataSource_organization,
+			"invalidActions": testAccObservabilityAccessManagerSinkPolicyDocumentDataSource_invalidActions,
+		},
 		"SinksDataSource": {
 			acctest.CtBasic: testAccObservabilityAccessManagerSinksDataSource_basic,
 		},
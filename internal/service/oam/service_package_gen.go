@@ -45,6 +45,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Sink",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  DataSourceSinkPolicyDocument,
+			TypeName: "aws_oam_sink_policy_document",
+			Name:     "Sink Policy Document",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  DataSourceSinks,
 			TypeName: "aws_oam_sinks",
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oam
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/oam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var organizationIDRegexp = regexache.MustCompile(`^o-[0-9a-z]{10,32}$`)
+
+const (
+	sinkPolicyActionCreateLink = "oam:CreateLink"
+	sinkPolicyActionUpdateLink = "oam:UpdateLink"
+)
+
+func sinkPolicyActions() []string {
+	return []string{
+		sinkPolicyActionCreateLink,
+		sinkPolicyActionUpdateLink,
+	}
+}
+
+// @SDKDataSource("aws_oam_sink_policy_document", name="Sink Policy Document")
+// @Region(overrideEnabled=false)
+func DataSourceSinkPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSinkPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"account_ids", "organization_ids"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(sinkPolicyActions(), false),
+				},
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"account_ids", "organization_ids"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(organizationIDRegexp, "must be a valid AWS Organizations organization ID"),
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.ResourceType](),
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameSinkPolicyDocument = "Sink Policy Document Data Source"
+)
+
+type sinkPolicyDocument struct {
+	Version   string                `json:"Version"`
+	Statement []sinkPolicyStatement `json:"Statement"`
+}
+
+type sinkPolicyStatement struct {
+	Sid       string                    `json:"Sid,omitempty"`
+	Effect    string                    `json:"Effect"`
+	Principal any                       `json:"Principal"`
+	Action    []string                  `json:"Action"`
+	Resource  string                    `json:"Resource"`
+	Condition map[string]map[string]any `json:"Condition"`
+}
+
+func dataSourceSinkPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	actions := sinkPolicyActions()
+	if v, ok := d.GetOk("actions"); ok && v.(*schema.Set).Len() > 0 {
+		actions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+	slices.Sort(actions)
+
+	resourceTypes := flex.ExpandStringValueSet(d.Get("resource_types").(*schema.Set))
+	slices.Sort(resourceTypes)
+
+	resourceTypesCondition := map[string]any{
+		"oam:ResourceTypes": resourceTypes,
+	}
+
+	var statements []sinkPolicyStatement
+
+	if v, ok := d.GetOk("account_ids"); ok && v.(*schema.Set).Len() > 0 {
+		accountIDs := flex.ExpandStringValueSet(v.(*schema.Set))
+		slices.Sort(accountIDs)
+
+		statements = append(statements, sinkPolicyStatement{
+			Sid:    "AllowAccounts",
+			Effect: "Allow",
+			Principal: map[string]any{
+				"AWS": accountIDs,
+			},
+			Action:   actions,
+			Resource: "*",
+			Condition: map[string]map[string]any{
+				"ForAllValues:StringEquals": resourceTypesCondition,
+			},
+		})
+	}
+
+	if v, ok := d.GetOk("organization_ids"); ok && v.(*schema.Set).Len() > 0 {
+		organizationIDs := flex.ExpandStringValueSet(v.(*schema.Set))
+		slices.Sort(organizationIDs)
+
+		statements = append(statements, sinkPolicyStatement{
+			Sid:       "AllowOrganizations",
+			Effect:    "Allow",
+			Principal: "*",
+			Action:    actions,
+			Resource:  "*",
+			Condition: map[string]map[string]any{
+				"ForAllValues:StringEquals": resourceTypesCondition,
+				"ForAnyValue:StringEquals": {
+					"aws:PrincipalOrgID": organizationIDs,
+				},
+			},
+		})
+	}
+
+	document := sinkPolicyDocument{
+		Version:   "2012-10-17",
+		Statement: statements,
+	}
+
+	b, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return create.AppendDiagError(diags, names.ObservabilityAccessManager, create.ErrActionReading, DSNameSinkPolicyDocument, "", err)
+	}
+
+	jsonString := string(b)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("actions", actions)
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oam_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccObservabilityAccessManagerSinkPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_oam_sink_policy_document.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ObservabilityAccessManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "oam:CreateLink"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "oam:UpdateLink"),
+					resource.TestCheckResourceAttrWith(dataSourceName, names.AttrJSON, func(value string) error {
+						_, err := awspolicy.PoliciesAreEquivalent(value, `
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowAccounts",
+    "Effect": "Allow",
+    "Principal": {"AWS": ["111111111111", "222222222222"]},
+    "Action": ["oam:CreateLink", "oam:UpdateLink"],
+    "Resource": "*",
+    "Condition": {
+      "ForAllValues:StringEquals": {
+        "oam:ResourceTypes": ["AWS::CloudWatch::Metric", "AWS::Logs::LogGroup"]
+      }
+    }
+  }]
+}
+`)
+						return err
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccObservabilityAccessManagerSinkPolicyDocumentDataSource_organization(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_oam_sink_policy_document.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ObservabilityAccessManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkPolicyDocumentDataSourceConfig_organization,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "oam:CreateLink"),
+					resource.TestCheckResourceAttrWith(dataSourceName, names.AttrJSON, func(value string) error {
+						_, err := awspolicy.PoliciesAreEquivalent(value, `
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowOrganizations",
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "oam:CreateLink",
+    "Resource": "*",
+    "Condition": {
+      "ForAllValues:StringEquals": {
+        "oam:ResourceTypes": "AWS::XRay::Trace"
+      },
+      "ForAnyValue:StringEquals": {
+        "aws:PrincipalOrgID": "o-abcdef1234"
+      }
+    }
+  }]
+}
+`)
+						return err
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccObservabilityAccessManagerSinkPolicyDocumentDataSource_invalidActions(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ObservabilityAccessManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSinkPolicyDocumentDataSourceConfig_invalidActions,
+				ExpectError: regexache.MustCompile(`expected actions\.\d+ to be one of`),
+			},
+		},
+	})
+}
+
+const testAccSinkPolicyDocumentDataSourceConfig_basic = `
+data "aws_oam_sink_policy_document" "test" {
+  account_ids    = ["111111111111", "222222222222"]
+  resource_types = ["AWS::CloudWatch::Metric", "AWS::Logs::LogGroup"]
+}
+`
+
+const testAccSinkPolicyDocumentDataSourceConfig_organization = `
+data "aws_oam_sink_policy_document" "test" {
+  actions          = ["oam:CreateLink"]
+  organization_ids = ["o-abcdef1234"]
+  resource_types   = ["AWS::XRay::Trace"]
+}
+`
+
+const testAccSinkPolicyDocumentDataSourceConfig_invalidActions = `
+data "aws_oam_sink_policy_document" "test" {
+  account_ids    = ["111111111111"]
+  actions        = ["oam:DeleteLink"]
+  resource_types = ["AWS::CloudWatch::Metric"]
+}
+`
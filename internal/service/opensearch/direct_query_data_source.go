@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_opensearch_direct_query_data_source", name="Direct Query Data Source")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newDirectQueryDataSourceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directQueryDataSourceResource{}
+
+	return r, nil
+}
+
+type directQueryDataSourceResource struct {
+	framework.ResourceWithModel[directQueryDataSourceResourceModel]
+}
+
+func (r *directQueryDataSourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	roleARNBlock := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1000),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 80),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z][0-9a-z_]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers, and underscores"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"open_search_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_source_type": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[directQueryDataSourceTypeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cloudwatch_log": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[directQueryDataSourceRoleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("cloudwatch_log"),
+									path.MatchRelative().AtParent().AtName("security_lake"),
+								),
+							},
+							NestedObject: roleARNBlock,
+						},
+						"security_lake": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[directQueryDataSourceRoleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: roleARNBlock,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directQueryDataSourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directQueryDataSourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchClient(ctx)
+
+	name := data.DataSourceName.ValueString()
+	var input opensearch.AddDirectQueryDataSourceInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.TagList = getTagsIn(ctx)
+
+	output, err := conn.AddDirectQueryDataSource(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating OpenSearch Direct Query Data Source (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DataSourceARN = fwflex.StringToFramework(ctx, output.DataSourceArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *directQueryDataSourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directQueryDataSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchClient(ctx)
+
+	name := data.DataSourceName.ValueString()
+	output, err := findDirectQueryDataSourceByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpenSearch Direct Query Data Source (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directQueryDataSourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new directQueryDataSourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchClient(ctx)
+
+	if !new.DataSourceType.Equal(old.DataSourceType) || !new.Description.Equal(old.Description) || !new.OpenSearchARNs.Equal(old.OpenSearchARNs) {
+		name := new.DataSourceName.ValueString()
+		var input opensearch.UpdateDirectQueryDataSourceInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDirectQueryDataSource(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating OpenSearch Direct Query Data Source (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directQueryDataSourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directQueryDataSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchClient(ctx)
+
+	name := data.DataSourceName.ValueString()
+	input := opensearch.DeleteDirectQueryDataSourceInput{
+		DataSourceName: aws.String(name),
+	}
+	_, err := conn.DeleteDirectQueryDataSource(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting OpenSearch Direct Query Data Source (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *directQueryDataSourceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+type directQueryDataSourceResourceModel struct {
+	framework.WithRegionModel
+	DataSourceARN  types.String                                                    `tfsdk:"arn"`
+	DataSourceName types.String                                                    `tfsdk:"name"`
+	DataSourceType fwtypes.ListNestedObjectValueOf[directQueryDataSourceTypeModel] `tfsdk:"data_source_type"`
+	Description    types.String                                                    `tfsdk:"description"`
+	OpenSearchARNs fwtypes.SetOfARN                                                `tfsdk:"open_search_arns"`
+	Tags           tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll        tftags.Map                                                      `tfsdk:"tags_all"`
+}
+
+type directQueryDataSourceTypeModel struct {
+	CloudWatchLog fwtypes.ListNestedObjectValueOf[directQueryDataSourceRoleModel] `tfsdk:"cloudwatch_log"`
+	SecurityLake  fwtypes.ListNestedObjectValueOf[directQueryDataSourceRoleModel] `tfsdk:"security_lake"`
+}
+
+var (
+	_ fwflex.Expander  = directQueryDataSourceTypeModel{}
+	_ fwflex.Flattener = &directQueryDataSourceTypeModel{}
+)
+
+func (m directQueryDataSourceTypeModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CloudWatchLog.IsNull():
+		cloudWatchLogData, d := m.CloudWatchLog.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.DirectQueryDataSourceTypeMemberCloudWatchLog
+		diags.Append(fwflex.Expand(ctx, cloudWatchLogData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.SecurityLake.IsNull():
+		securityLakeData, d := m.SecurityLake.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.DirectQueryDataSourceTypeMemberSecurityLake
+		diags.Append(fwflex.Expand(ctx, securityLakeData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *directQueryDataSourceTypeModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.DirectQueryDataSourceTypeMemberCloudWatchLog:
+		var model directQueryDataSourceRoleModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CloudWatchLog = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.DirectQueryDataSourceTypeMemberSecurityLake:
+		var model directQueryDataSourceRoleModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.SecurityLake = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type directQueryDataSourceRoleModel struct {
+	RoleARN fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+func findDirectQueryDataSourceByName(ctx context.Context, conn *opensearch.Client, name string) (*opensearch.GetDirectQueryDataSourceOutput, error) {
+	input := opensearch.GetDirectQueryDataSourceInput{
+		DataSourceName: aws.String(name),
+	}
+
+	output, err := conn.GetDirectQueryDataSource(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchDirectQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v opensearch.GetDirectQueryDataSourceOutput
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_direct_query_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectQueryDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectQueryDataSourceConfig_cloudWatchLog(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectQueryDataSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "data_source_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_source_type.0.cloudwatch_log.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source_type.0.cloudwatch_log.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "data_source_type.0.security_lake.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "open_search_arns.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        testAccDirectQueryDataSourceName(rName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccDirectQueryDataSourceConfig_cloudWatchLog(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectQueryDataSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchDirectQueryDataSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v opensearch.GetDirectQueryDataSourceOutput
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_direct_query_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectQueryDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectQueryDataSourceConfig_cloudWatchLog(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectQueryDataSourceExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfopensearch.ResourceDirectQueryDataSource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDirectQueryDataSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearch_direct_query_data_source" {
+				continue
+			}
+
+			_, err := tfopensearch.FindDirectQueryDataSourceByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch Direct Query Data Source %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectQueryDataSourceExists(ctx context.Context, n string, v *opensearch.GetDirectQueryDataSourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchClient(ctx)
+
+		output, err := tfopensearch.FindDirectQueryDataSourceByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccDirectQueryDataSourceName returns a data source name, which may not contain hyphens.
+func testAccDirectQueryDataSourceName(rName string) string {
+	return strings.ReplaceAll(rName, "-", "_")
+}
+
+func testAccDirectQueryDataSourceConfig_cloudWatchLog(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "directquery.opensearchservice.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/CloudWatchLogsReadOnlyAccess"
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_opensearch_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_opensearch_direct_query_data_source" "test" {
+  name             = %[2]q
+  description      = %[3]q
+  open_search_arns = [aws_opensearch_application.test.arn]
+
+  data_source_type {
+    cloudwatch_log {
+      role_arn = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, testAccDirectQueryDataSourceName(rName), description)
+}
@@ -12,12 +12,16 @@ var (
 	ResourcePackageAssociation         = resourcePackageAssociation
 	ResourceVPCEndpoint                = resourceVPCEndpoint
 	ResourceAuthorizeVPCEndpointAccess = newAuthorizeVPCEndpointAccessResource
+	ResourceApplication                = newApplicationResource
+	ResourceDirectQueryDataSource      = newDirectQueryDataSourceResource
 
 	FindDomainByName                     = findDomainByName
 	FindPackageByID                      = findPackageByID
 	FindPackageAssociationByTwoPartKey   = findPackageAssociationByTwoPartKey
 	FindVPCEndpointByID                  = findVPCEndpointByID
 	FindAuthorizeVPCEndpointAccessByName = findAuthorizeVPCEndpointAccessByName
+	FindApplicationByID                  = findApplicationByID
+	FindDirectQueryDataSourceByName      = findDirectQueryDataSourceByName
 
 	EBSVolumeTypePermitsIopsInput       = ebsVolumeTypePermitsIopsInput
 	EBSVolumeTypePermitsThroughputInput = ebsVolumeTypePermitsThroughputInput
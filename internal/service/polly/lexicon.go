@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/polly/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_polly_lexicon", name="Lexicon")
+func newLexiconResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lexiconResource{}, nil
+}
+
+type lexiconResource struct {
+	framework.ResourceWithModel[lexiconResourceModel]
+	framework.WithImportByID
+}
+
+func (r *lexiconResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alphabet": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrContent: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrLanguageCode: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LanguageCode](),
+				Computed:   true,
+			},
+			"last_modified": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"lexemes_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z]{1,20}$`), "must be 1 to 20 alphanumeric characters"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSize: schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *lexiconResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lexiconResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input polly.PutLexiconInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutLexicon(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Polly Lexicon (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findLexiconByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Polly Lexicon (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, name)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.LexiconAttributes, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lexiconResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lexiconResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findLexiconByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Polly Lexicon (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.LexiconAttributes, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only report content drift when the PLS document differs by more than formatting.
+	if content := aws.ToString(output.Lexicon.Content); normalizeLexiconContent(content) != normalizeLexiconContent(data.Content.ValueString()) {
+		data.Content = fwflex.StringValueToFramework(ctx, content)
+	}
+	data.Name = fwflex.StringToFramework(ctx, output.Lexicon.Name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lexiconResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new lexiconResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, new.ID)
+	var input polly.PutLexiconInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutLexicon(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Polly Lexicon (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findLexiconByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Polly Lexicon (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.LexiconAttributes, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lexiconResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lexiconResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PollyClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := polly.DeleteLexiconInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteLexicon(ctx, &input)
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Polly Lexicon (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func findLexiconByName(ctx context.Context, conn *polly.Client, name string) (*polly.GetLexiconOutput, error) {
+	input := polly.GetLexiconInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.GetLexicon(ctx, &input)
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lexicon == nil || output.LexiconAttributes == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// normalizeLexiconContent removes insignificant whitespace from a PLS document.
+func normalizeLexiconContent(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = regexache.MustCompile(`>\s+<`).ReplaceAllString(s, "><")
+
+	return strings.TrimSpace(s)
+}
+
+type lexiconResourceModel struct {
+	framework.WithRegionModel
+	Alphabet     types.String                              `tfsdk:"alphabet"`
+	Content      types.String                              `tfsdk:"content"`
+	ID           types.String                              `tfsdk:"id"`
+	LanguageCode fwtypes.StringEnum[awstypes.LanguageCode] `tfsdk:"language_code"`
+	LastModified timetypes.RFC3339                         `tfsdk:"last_modified"`
+	LexemesCount types.Int64                               `tfsdk:"lexemes_count"`
+	LexiconARN   types.String                              `tfsdk:"arn"`
+	Name         types.String                              `tfsdk:"name"`
+	Size         types.Int64                               `tfsdk:"size"`
+}
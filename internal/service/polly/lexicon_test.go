@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolly "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNormalizeLexiconContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b  string
+		equal bool
+	}{
+		"identical": {
+			a:     `<lexicon><lexeme><grapheme>W3C</grapheme></lexeme></lexicon>`,
+			b:     `<lexicon><lexeme><grapheme>W3C</grapheme></lexeme></lexicon>`,
+			equal: true,
+		},
+		"indentation": {
+			a:     "<lexicon>\n  <lexeme>\n    <grapheme>W3C</grapheme>\n  </lexeme>\n</lexicon>\n",
+			b:     `<lexicon><lexeme><grapheme>W3C</grapheme></lexeme></lexicon>`,
+			equal: true,
+		},
+		"line endings": {
+			a:     "<lexicon>\r\n<lexeme/>\r\n</lexicon>",
+			b:     "<lexicon>\n<lexeme/>\n</lexicon>",
+			equal: true,
+		},
+		"text content": {
+			a:     `<lexicon><lexeme><grapheme>W3C</grapheme></lexeme></lexicon>`,
+			b:     `<lexicon><lexeme><grapheme>W 3 C</grapheme></lexeme></lexicon>`,
+			equal: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfpolly.NormalizeLexiconContent(testCase.a) == tfpolly.NormalizeLexiconContent(testCase.b); got != testCase.equal {
+				t.Errorf("equal = %t, want %t", got, testCase.equal)
+			}
+		})
+	}
+}
+
+func TestAccPollyLexicon_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "ipa"),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "polly", "lexicon/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrLanguageCode, "en-US"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpolly.ResourceLexicon, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLexiconDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_polly_lexicon" {
+				continue
+			}
+
+			_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Polly Lexicon %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLexiconExists(ctx context.Context, n string, v *polly.GetLexiconOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		output, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLexiconConfig_basic(rName, alias string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name = %[1]q
+
+  content = <<EOT
+<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0"
+    xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"
+    xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+    xsi:schemaLocation="http://www.w3.org/2005/01/pronunciation-lexicon
+        http://www.w3.org/TR/2007/CR-pronunciation-lexicon-20071212/pls.xsd"
+    alphabet="ipa"
+    xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>%[2]s</alias>
+  </lexeme>
+</lexicon>
+EOT
+}
+`, rName, alias)
+}
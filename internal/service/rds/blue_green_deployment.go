@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_rds_blue_green_deployment", name="Blue Green Deployment")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newBlueGreenDeploymentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &blueGreenDeploymentResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type blueGreenDeploymentResource struct {
+	framework.ResourceWithModel[blueGreenDeploymentResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *blueGreenDeploymentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"blue_green_deployment_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_target": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrSource: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			"switchover": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"switchover_timeout": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTarget: schema.StringAttribute{
+				Computed: true,
+			},
+			"target_db_cluster_parameter_group_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_db_instance_class": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_db_parameter_group_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_engine_version": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_iops": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"target_storage_type": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"upgrade_target_storage_config": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *blueGreenDeploymentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.BlueGreenDeploymentName)
+	var input rds.CreateBlueGreenDeploymentInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateBlueGreenDeployment(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS Blue/Green Deployment (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+	deadline := inttypes.NewDeadline(r.CreateTimeout(ctx, data.Timeouts))
+	deployment, err := waitBlueGreenDeploymentAvailable(ctx, conn, id, deadline.Remaining())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Blue/Green Deployment (%s) create", id), err.Error())
+
+		return
+	}
+
+	if data.Switchover.ValueBool() {
+		deployment, err = switchoverBlueGreenDeployment(ctx, conn, id, fwflex.Int32FromFrameworkInt64(ctx, data.SwitchoverTimeout), deadline.Remaining())
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("switching over RDS Blue/Green Deployment (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, r.blueGreenDeploymentARN(ctx, id))
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+	data.Status = fwflex.StringToFramework(ctx, deployment.Status)
+	data.Target = fwflex.StringToFramework(ctx, deployment.Target)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *blueGreenDeploymentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findBlueGreenDeploymentByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringValueToFramework(ctx, r.blueGreenDeploymentARN(ctx, id))
+	data.BlueGreenDeploymentName = fwflex.StringToFramework(ctx, output.BlueGreenDeploymentName)
+	data.Source = fwflex.StringToFrameworkARN(ctx, output.Source)
+	data.Status = fwflex.StringToFramework(ctx, output.Status)
+	data.Target = fwflex.StringToFramework(ctx, output.Target)
+
+	setTagsOut(ctx, output.TagList)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *blueGreenDeploymentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ID)
+	deployment, err := findBlueGreenDeploymentByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s)", id), err.Error())
+
+		return
+	}
+
+	// A switchover cannot be reversed, so only a change from false to true has any effect.
+	if new.Switchover.ValueBool() && !old.Switchover.ValueBool() && aws.ToString(deployment.Status) != blueGreenDeploymentStatusSwitchoverCompleted {
+		deployment, err = switchoverBlueGreenDeployment(ctx, conn, id, fwflex.Int32FromFrameworkInt64(ctx, new.SwitchoverTimeout), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("switching over RDS Blue/Green Deployment (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	new.Status = fwflex.StringToFramework(ctx, deployment.Status)
+	new.Target = fwflex.StringToFramework(ctx, deployment.Target)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *blueGreenDeploymentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	}
+	// The target (green) environment can only be deleted before switchover.
+	if data.DeleteTarget.ValueBool() && data.Status.ValueString() != blueGreenDeploymentStatusSwitchoverCompleted {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	_, err := conn.DeleteBlueGreenDeployment(ctx, &input)
+
+	if errs.IsA[*awstypes.BlueGreenDeploymentNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting RDS Blue/Green Deployment (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Blue/Green Deployment (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *blueGreenDeploymentResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("delete_target"), false)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("switchover"), false)...)
+}
+
+func (r *blueGreenDeploymentResource) blueGreenDeploymentARN(ctx context.Context, id string) string {
+	return r.Meta().RegionalARN(ctx, "rds", "deployment:"+id)
+}
+
+const (
+	blueGreenDeploymentStatusSwitchoverCompleted = "SWITCHOVER_COMPLETED"
+)
+
+func switchoverBlueGreenDeployment(ctx context.Context, conn *rds.Client, id string, switchoverTimeout *int32, timeout time.Duration) (*awstypes.BlueGreenDeployment, error) {
+	input := rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+		SwitchoverTimeout:             switchoverTimeout,
+	}
+	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
+		func(ctx context.Context) (any, error) {
+			return conn.SwitchoverBlueGreenDeployment(ctx, &input)
+		},
+		func(err error) (bool, error) {
+			return errs.IsA[*awstypes.InvalidBlueGreenDeploymentStateFault](err), err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := waitBlueGreenDeploymentSwitchoverCompleted(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return output, nil
+}
+
+type blueGreenDeploymentResourceModel struct {
+	framework.WithRegionModel
+	ARN                               types.String   `tfsdk:"arn"`
+	BlueGreenDeploymentName           types.String   `tfsdk:"blue_green_deployment_name"`
+	DeleteTarget                      types.Bool     `tfsdk:"delete_target"`
+	ID                                types.String   `tfsdk:"id"`
+	Source                            fwtypes.ARN    `tfsdk:"source"`
+	Status                            types.String   `tfsdk:"status"`
+	Switchover                        types.Bool     `tfsdk:"switchover"`
+	SwitchoverTimeout                 types.Int64    `tfsdk:"switchover_timeout"`
+	Tags                              tftags.Map     `tfsdk:"tags"`
+	TagsAll                           tftags.Map     `tfsdk:"tags_all"`
+	Target                            types.String   `tfsdk:"target"`
+	TargetDBClusterParameterGroupName types.String   `tfsdk:"target_db_cluster_parameter_group_name"`
+	TargetDBInstanceClass             types.String   `tfsdk:"target_db_instance_class"`
+	TargetDBParameterGroupName        types.String   `tfsdk:"target_db_parameter_group_name"`
+	TargetEngineVersion               types.String   `tfsdk:"target_engine_version"`
+	TargetIops                        types.Int64    `tfsdk:"target_iops"`
+	TargetStorageType                 types.String   `tfsdk:"target_storage_type"`
+	Timeouts                          timeouts.Value `tfsdk:"timeouts"`
+	UpgradeTargetStorageConfig        types.Bool     `tfsdk:"upgrade_target_storage_config"`
+}
@@ -85,6 +85,31 @@ func TestAccRDSClusterDataSource_ManagedMasterPassword_managed(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterDataSource_serverlessV2ScalingConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rds_cluster.test"
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterDataSourceConfig_serverlessV2ScalingConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "serverlessv2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "serverlessv2_scaling_configuration.0.max_capacity", resourceName, "serverlessv2_scaling_configuration.0.max_capacity"),
+					resource.TestCheckResourceAttr(dataSourceName, "serverlessv2_scaling_configuration.0.min_capacity", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "serverlessv2_scaling_configuration.0.seconds_until_auto_pause", "3600"),
+				),
+			},
+		},
+	})
+}
+
 func testAccClusterDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_db_subnet_group" "test" {
@@ -138,3 +163,32 @@ data "aws_rds_cluster" "test" {
 }
 `, rName))
 }
+
+func testAccClusterDataSourceConfig_serverlessV2ScalingConfiguration(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = "aurora-postgresql"
+  engine_latest_version      = true
+  preferred_instance_classes = ["db.serverless"]
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  engine_version      = data.aws_rds_orderable_db_instance.test.engine_version
+
+  serverlessv2_scaling_configuration {
+    max_capacity             = 4.0
+    min_capacity             = 0.0
+    seconds_until_auto_pause = 3600
+  }
+}
+
+data "aws_rds_cluster" "test" {
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+}
+`, rName)
+}
@@ -58,6 +58,41 @@ func TestAccRDSExportTask_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSExportTask_cluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	var exportTask types.ExportTask
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_export_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckExportTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskConfig_cluster(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(ctx, resourceName, &exportTask),
+					resource.TestCheckResourceAttr(resourceName, "export_task_identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_db_cluster_snapshot.test", "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "export_only.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "export_only.0", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccRDSExportTask_optional(t *testing.T) {
 	ctx := acctest.Context(t)
 	var exportTask types.ExportTask
@@ -152,10 +187,8 @@ func testAccCheckExportTaskExists(ctx context.Context, n string, v *types.Export
 	}
 }
 
-func testAccExportTaskConfig_base(rName string) string {
-	return acctest.ConfigCompose(
-		acctest.ConfigRandomPassword(),
-		fmt.Sprintf(`
+func testAccExportTaskConfig_baseExport(rName string) string {
+	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket        = %[1]q
   force_destroy = true
@@ -222,7 +255,14 @@ resource "aws_iam_role_policy_attachment" "test-attach" {
 resource "aws_kms_key" "test" {
   deletion_window_in_days = 10
 }
+`, rName)
+}
 
+func testAccExportTaskConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccExportTaskConfig_baseExport(rName),
+		fmt.Sprintf(`
 resource "aws_db_instance" "test" {
   identifier          = %[1]q
   allocated_storage   = 10
@@ -272,3 +312,35 @@ resource "aws_rds_export_task" "test" {
 }
 `, rName, s3Prefix))
 }
+
+func testAccExportTaskConfig_cluster(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccExportTaskConfig_baseExport(rName),
+		fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier         = %[1]q
+  database_name              = "test"
+  engine                     = "aurora-mysql"
+  master_username            = "foo"
+  master_password_wo         = ephemeral.aws_secretsmanager_random_password.test.random_password
+  master_password_wo_version = 1
+  skip_final_snapshot        = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_rds_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+}
+
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_cluster_snapshot.test.db_cluster_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+
+  export_only = ["test"]
+}
+`, rName))
+}
@@ -1073,6 +1073,47 @@ func TestAccRDSInstance_ManageMasterPassword_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_ManageMasterPassword_rotation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	rotationResourceName := "aws_secretsmanager_secret_rotation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_manageMasterPasswordRotation(rName, "cron(0 4 ? * SUN *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(rotationResourceName, "secret_id", resourceName, "master_user_secret.0.secret_arn"),
+					resource.TestCheckResourceAttr(rotationResourceName, "rotation_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(rotationResourceName, "rotation_rules.0.schedule_expression", "cron(0 4 ? * SUN *)"),
+					resource.TestCheckResourceAttr(rotationResourceName, "rotation_rules.0.duration", "2h"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_manageMasterPasswordRotation(rName, "cron(0 6 ? * SAT *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(rotationResourceName, "rotation_rules.0.schedule_expression", "cron(0 6 ? * SAT *)"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_ErrorOnConvertToManageOnStoppedInstance(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -10319,6 +10360,22 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_manageMasterPasswordRotation(rName, scheduleExpression string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_manageMasterPassword(rName),
+		fmt.Sprintf(`
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id          = aws_db_instance.test.master_user_secret[0].secret_arn
+  rotate_immediately = false
+
+  rotation_rules {
+    schedule_expression = %[1]q
+    duration            = "2h"
+  }
+}
+`, scheduleExpression))
+}
+
 func testAccInstanceConfig_passwordWithStoppedInstance(rName, password string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
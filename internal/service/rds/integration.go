@@ -42,6 +42,7 @@ func newIntegrationResource(_ context.Context) (resource.ResourceWithConfigure,
 	r := &integrationResource{}
 
 	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
 	r.SetDefaultDeleteTimeout(30 * time.Minute)
 
 	return r, nil
@@ -79,16 +80,15 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
 			names.AttrID: framework.IDAttributeDeprecatedWithAlternate(path.Root(names.AttrARN)),
 			"integration_name": schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			names.AttrKMSKeyID: schema.StringAttribute{
 				Optional: true,
@@ -118,6 +118,7 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -219,6 +220,50 @@ func (r *integrationResource) Read(ctx context.Context, request resource.ReadReq
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *integrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new integrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	if !new.DataFilter.Equal(old.DataFilter) || !new.Description.Equal(old.Description) || !new.IntegrationName.Equal(old.IntegrationName) {
+		input := rds.ModifyIntegrationInput{
+			IntegrationIdentifier: fwflex.StringFromFramework(ctx, new.ID),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.ModifyIntegration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Integration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		integration, err := waitIntegrationUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Integration (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DataFilter = fwflex.StringToFramework(ctx, integration.DataFilter)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
 func (r *integrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data integrationResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -330,6 +375,29 @@ func waitIntegrationCreated(ctx context.Context, conn *rds.Client, arn string, t
 	return nil, err
 }
 
+func waitIntegrationUpdated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	const (
+		delay = 30 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{integrationStatusModifying},
+		Target:  []string{integrationStatusActive},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Delay:   delay,
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, integrationError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitIntegrationDeleted(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{integrationStatusDeleting, integrationStatusActive},
@@ -357,6 +425,7 @@ type integrationResourceModel struct {
 	framework.WithRegionModel
 	AdditionalEncryptionContext fwtypes.MapOfString `tfsdk:"additional_encryption_context"`
 	DataFilter                  types.String        `tfsdk:"data_filter"`
+	Description                 types.String        `tfsdk:"description"`
 	ID                          types.String        `tfsdk:"id"`
 	IntegrationARN              types.String        `tfsdk:"arn"`
 	IntegrationName             types.String        `tfsdk:"integration_name"`
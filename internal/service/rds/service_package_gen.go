@@ -39,6 +39,15 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newBlueGreenDeploymentResource,
+			TypeName: "aws_rds_blue_green_deployment",
+			Name:     "Blue Green Deployment",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newClusterSnapshotCopyResource,
 			TypeName: "aws_rds_cluster_snapshot_copy",
@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_redshiftserverless_scheduled_action", name="Scheduled Action")
+func newScheduledActionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &scheduledActionResource{}
+
+	return r, nil
+}
+
+type scheduledActionResource struct {
+	framework.ResourceWithModel[scheduledActionResourceModel]
+}
+
+func (r *scheduledActionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 60),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z-]+$`), "must contain only lowercase letters, numbers, and hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"at": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("at"),
+									path.MatchRelative().AtParent().AtName("cron"),
+								),
+							},
+						},
+						"cron": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"target_action": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[targetActionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"create_snapshot": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[createSnapshotScheduleActionParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRetentionPeriod: schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+									},
+									"snapshot_name_prefix": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *scheduledActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data scheduledActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	name := data.ScheduledActionName.ValueString()
+	var input redshiftserverless.CreateScheduledActionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	setScheduledActionTargetNamespace(input.TargetAction, input.NamespaceName)
+
+	output, err := conn.CreateScheduledAction(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Serverless Scheduled Action (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output.ScheduledAction)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *scheduledActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data scheduledActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	name := data.ScheduledActionName.ValueString()
+	output, err := findScheduledActionByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Serverless Scheduled Action (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new scheduledActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	name := new.ScheduledActionName.ValueString()
+	var input redshiftserverless.UpdateScheduledActionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	setScheduledActionTargetNamespace(input.TargetAction, new.NamespaceName.ValueStringPointer())
+
+	output, err := conn.UpdateScheduledAction(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Redshift Serverless Scheduled Action (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(new.flatten(ctx, output.ScheduledAction)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *scheduledActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data scheduledActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	name := data.ScheduledActionName.ValueString()
+	input := redshiftserverless.DeleteScheduledActionInput{
+		ScheduledActionName: aws.String(name),
+	}
+	_, err := conn.DeleteScheduledAction(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Serverless Scheduled Action (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *scheduledActionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+// setScheduledActionTargetNamespace fills in the target namespace, which is always the scheduled action's own namespace.
+func setScheduledActionTargetNamespace(targetAction awstypes.TargetAction, namespaceName *string) {
+	if v, ok := targetAction.(*awstypes.TargetActionMemberCreateSnapshot); ok {
+		v.Value.NamespaceName = namespaceName
+	}
+}
+
+func findScheduledActionByName(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.ScheduledActionResponse, error) {
+	input := redshiftserverless.GetScheduledActionInput{
+		ScheduledActionName: aws.String(name),
+	}
+
+	output, err := conn.GetScheduledAction(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ScheduledAction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ScheduledAction, nil
+}
+
+type scheduledActionResourceModel struct {
+	framework.WithRegionModel
+	Enabled                    types.Bool                                         `tfsdk:"enabled"`
+	EndTime                    timetypes.RFC3339                                  `tfsdk:"end_time"`
+	NamespaceName              types.String                                       `tfsdk:"namespace_name"`
+	RoleARN                    fwtypes.ARN                                        `tfsdk:"role_arn"`
+	Schedule                   fwtypes.ListNestedObjectValueOf[scheduleModel]     `tfsdk:"schedule"`
+	ScheduledActionDescription types.String                                       `tfsdk:"description"`
+	ScheduledActionName        types.String                                       `tfsdk:"name"`
+	StartTime                  timetypes.RFC3339                                  `tfsdk:"start_time"`
+	TargetAction               fwtypes.ListNestedObjectValueOf[targetActionModel] `tfsdk:"target_action"`
+}
+
+func (m *scheduledActionResourceModel) flatten(ctx context.Context, v *awstypes.ScheduledActionResponse) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, v, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API reports the state rather than the enabled flag.
+	m.Enabled = types.BoolValue(v.State == awstypes.StateActive)
+
+	return diags
+}
+
+type scheduleModel struct {
+	At   timetypes.RFC3339 `tfsdk:"at"`
+	Cron types.String      `tfsdk:"cron"`
+}
+
+var (
+	_ fwflex.Expander  = scheduleModel{}
+	_ fwflex.Flattener = &scheduleModel{}
+)
+
+func (m scheduleModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.At.IsNull():
+		t, d := m.At.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.ScheduleMemberAt{Value: t}, diags
+
+	case !m.Cron.IsNull():
+		return &awstypes.ScheduleMemberCron{Value: m.Cron.ValueString()}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *scheduleModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.At = timetypes.NewRFC3339Null()
+	m.Cron = types.StringNull()
+
+	switch t := v.(type) {
+	case awstypes.ScheduleMemberAt:
+		m.At = timetypes.NewRFC3339TimeValue(t.Value)
+
+	case awstypes.ScheduleMemberCron:
+		m.Cron = types.StringValue(t.Value)
+	}
+
+	return diags
+}
+
+type targetActionModel struct {
+	CreateSnapshot fwtypes.ListNestedObjectValueOf[createSnapshotScheduleActionParametersModel] `tfsdk:"create_snapshot"`
+}
+
+var (
+	_ fwflex.Expander  = targetActionModel{}
+	_ fwflex.Flattener = &targetActionModel{}
+)
+
+func (m targetActionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CreateSnapshot.IsNull():
+		createSnapshotData, d := m.CreateSnapshot.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.TargetActionMemberCreateSnapshot
+		diags.Append(fwflex.Expand(ctx, createSnapshotData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *targetActionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.TargetActionMemberCreateSnapshot:
+		var model createSnapshotScheduleActionParametersModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CreateSnapshot = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type createSnapshotScheduleActionParametersModel struct {
+	RetentionPeriod    types.Int32  `tfsdk:"retention_period"`
+	SnapshotNamePrefix types.String `tfsdk:"snapshot_name_prefix"`
+}
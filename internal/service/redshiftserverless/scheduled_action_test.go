@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftServerlessScheduledAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ScheduledActionResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_cron(rName, "cron(0 12 * * ? *)", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_name", "aws_redshiftserverless_namespace.test", "namespace_name"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", "cron(0 12 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.retention_period", "7"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.snapshot_name_prefix", rName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        rName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccScheduledActionConfig_cron(rName, "cron(0 6 * * ? *)", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", "cron(0 6 * * ? *)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessScheduledAction_at(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ScheduledActionResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+	at := time.Now().UTC().AddDate(0, 0, 1).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_at(rName, at),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.at", at),
+					resource.TestCheckNoResourceAttr(resourceName, "schedule.0.cron"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        rName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessScheduledAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ScheduledActionResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_cron(rName, "cron(0 12 * * ? *)", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfredshiftserverless.ResourceScheduledAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshiftserverless_scheduled_action" {
+				continue
+			}
+
+			_, err := tfredshiftserverless.FindScheduledActionByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Serverless Scheduled Action %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScheduledActionExists(ctx context.Context, n string, v *awstypes.ScheduledActionResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+		output, err := tfredshiftserverless.FindScheduledActionByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccScheduledActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "scheduler.redshift.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "redshift-serverless:CreateSnapshot"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccScheduledActionConfig_cron(rName, cron string, enabled bool) string {
+	return acctest.ConfigCompose(testAccScheduledActionConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshiftserverless_scheduled_action" "test" {
+  name           = %[1]q
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  role_arn       = aws_iam_role.test.arn
+  enabled        = %[3]t
+
+  schedule {
+    cron = %[2]q
+  }
+
+  target_action {
+    create_snapshot {
+      snapshot_name_prefix = %[1]q
+      retention_period     = 7
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, cron, enabled))
+}
+
+func testAccScheduledActionConfig_at(rName, at string) string {
+	return acctest.ConfigCompose(testAccScheduledActionConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshiftserverless_scheduled_action" "test" {
+  name           = %[1]q
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  role_arn       = aws_iam_role.test.arn
+
+  schedule {
+    at = %[2]q
+  }
+
+  target_action {
+    create_snapshot {
+      snapshot_name_prefix = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, at))
+}
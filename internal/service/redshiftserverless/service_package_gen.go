@@ -29,6 +29,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Custom Domain Association",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newScheduledActionResource,
+			TypeName: "aws_redshiftserverless_scheduled_action",
+			Name:     "Scheduled Action",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newSnapshotCopyConfigurationResource,
+			TypeName: "aws_redshiftserverless_snapshot_copy_configuration",
+			Name:     "Snapshot Copy Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_redshiftserverless_snapshot_copy_configuration", name="Snapshot Copy Configuration")
+func newSnapshotCopyConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &snapshotCopyConfigurationResource{}
+
+	return r, nil
+}
+
+type snapshotCopyConfigurationResource struct {
+	framework.ResourceWithModel[snapshotCopyConfigurationResourceModel]
+	framework.WithImportByID
+}
+
+func (r *snapshotCopyConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"destination_kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"namespace_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_retention_period": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *snapshotCopyConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	namespaceName := data.NamespaceName.ValueString()
+	var input redshiftserverless.CreateSnapshotCopyConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateSnapshotCopyConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Serverless Snapshot Copy Configuration (%s)", namespaceName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.SnapshotCopyConfiguration, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *snapshotCopyConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	id := data.SnapshotCopyConfigurationID.ValueString()
+	output, err := findSnapshotCopyConfigurationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Serverless Snapshot Copy Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *snapshotCopyConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	if !new.SnapshotRetentionPeriod.Equal(old.SnapshotRetentionPeriod) {
+		id := new.SnapshotCopyConfigurationID.ValueString()
+		input := redshiftserverless.UpdateSnapshotCopyConfigurationInput{
+			SnapshotCopyConfigurationId: aws.String(id),
+			SnapshotRetentionPeriod:     fwflex.Int32FromFramework(ctx, new.SnapshotRetentionPeriod),
+		}
+
+		output, err := conn.UpdateSnapshotCopyConfiguration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Redshift Serverless Snapshot Copy Configuration (%s)", id), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.SnapshotCopyConfiguration, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *snapshotCopyConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data snapshotCopyConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	id := data.SnapshotCopyConfigurationID.ValueString()
+	input := redshiftserverless.DeleteSnapshotCopyConfigurationInput{
+		SnapshotCopyConfigurationId: aws.String(id),
+	}
+	_, err := conn.DeleteSnapshotCopyConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Serverless Snapshot Copy Configuration (%s)", id), err.Error())
+
+		return
+	}
+}
+
+// findSnapshotCopyConfigurationByID lists the snapshot copy configurations for every namespace, as there is no Get API.
+func findSnapshotCopyConfigurationByID(ctx context.Context, conn *redshiftserverless.Client, id string) (*awstypes.SnapshotCopyConfiguration, error) {
+	var input redshiftserverless.ListSnapshotCopyConfigurationsInput
+	output, err := findSnapshotCopyConfigurations(ctx, conn, &input, func(v *awstypes.SnapshotCopyConfiguration) bool {
+		return aws.ToString(v.SnapshotCopyConfigurationId) == id
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSnapshotCopyConfigurations(ctx context.Context, conn *redshiftserverless.Client, input *redshiftserverless.ListSnapshotCopyConfigurationsInput, filter tfslices.Predicate[*awstypes.SnapshotCopyConfiguration]) ([]awstypes.SnapshotCopyConfiguration, error) {
+	var output []awstypes.SnapshotCopyConfiguration
+
+	pages := redshiftserverless.NewListSnapshotCopyConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SnapshotCopyConfigurations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type snapshotCopyConfigurationResourceModel struct {
+	framework.WithRegionModel
+	DestinationKMSKeyID          types.String `tfsdk:"destination_kms_key_id"`
+	DestinationRegion            types.String `tfsdk:"destination_region"`
+	NamespaceName                types.String `tfsdk:"namespace_name"`
+	SnapshotCopyConfigurationARN types.String `tfsdk:"arn"`
+	SnapshotCopyConfigurationID  types.String `tfsdk:"id"`
+	SnapshotRetentionPeriod      types.Int32  `tfsdk:"snapshot_retention_period"`
+}
@@ -6,13 +6,15 @@ package rekognition
 // Exports for use in tests only.
 
 var (
-	ResourceProject         = newProjectResource
 	ResourceCollection      = newCollectionResource
+	ResourceProject         = newProjectResource
+	ResourceProjectVersion  = newProjectVersionResource
 	ResourceStreamProcessor = newStreamProcessorResource
 )
 
 var (
-	FindCollectionByID        = findCollectionByID
-	FindProjectByName         = findProjectByName
-	FindStreamProcessorByName = findStreamProcessorByName
+	FindCollectionByID             = findCollectionByID
+	FindProjectByName              = findProjectByName
+	FindProjectVersionByTwoPartKey = findProjectVersionByTwoPartKey
+	FindStreamProcessorByName      = findStreamProcessorByName
 )
@@ -0,0 +1,760 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_rekognition_project_version", name="Project Version")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newProjectVersionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &projectVersionResource{}
+
+	r.SetDefaultCreateTimeout(180 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type projectVersionResource struct {
+	framework.ResourceWithModel[projectVersionResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+const (
+	ResNameProjectVersion = "Project Version"
+)
+
+func (r *projectVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	s3ObjectBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[s3ObjectModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrBucket: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrVersion: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+	assetsBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[assetModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"ground_truth_manifest": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[groundTruthManifestModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"s3_object": s3ObjectBlock,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"feature": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CustomizationFeature](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_inference_units": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtLeastSumOf(path.MatchRoot("min_inference_units")),
+				},
+			},
+			"min_inference_units": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"project_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"running": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProjectVersionStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"version_description": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"feature_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customizationFeatureConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"content_moderation": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customizationFeatureContentModerationConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"confidence_threshold": schema.Float64Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Float64{
+											float64validator.Between(0, 100),
+										},
+										PlanModifiers: []planmodifier.Float64{
+											float64planmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_bucket": schema.StringAttribute{
+							Required: true,
+						},
+						"s3_key_prefix": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"testing_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testingDataModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_create": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"assets": assetsBlock,
+					},
+				},
+			},
+			"training_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[trainingDataModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"assets": assetsBlock,
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *projectVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan projectVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := plan.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, plan.VersionName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := rekognition.CreateProjectVersionInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateProjectVersion(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.ProjectVersionArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, id, nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, id)
+	plan.ARN = fwflex.StringToFramework(ctx, out.ProjectVersionArn)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	version, err := waitProjectVersionTrainingCompleted(ctx, conn, plan.ProjectARN.ValueString(), plan.VersionName.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForCreation, ResNameProjectVersion, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.Running.ValueBool() {
+		version, err = startProjectVersion(ctx, conn, &plan, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, id, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *projectVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state projectVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.InitFromID(); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findProjectVersionByTwoPartKey(ctx, conn, state.ProjectARN.ValueString(), state.VersionName.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *projectVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan, state projectVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+	inferenceUnitsChanged := !plan.MinInferenceUnits.Equal(state.MinInferenceUnits) || !plan.MaxInferenceUnits.Equal(state.MaxInferenceUnits)
+
+	if !plan.Running.Equal(state.Running) || (plan.Running.ValueBool() && inferenceUnitsChanged) {
+		// Inference units can only be changed by restarting the model.
+		if state.Running.ValueBool() {
+			if err := stopProjectVersion(ctx, conn, &plan, updateTimeout); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameProjectVersion, id, err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		if plan.Running.ValueBool() {
+			if _, err := startProjectVersion(ctx, conn, &plan, updateTimeout); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameProjectVersion, id, err),
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	out, err := findProjectVersionByTwoPartKey(ctx, conn, plan.ProjectARN.ValueString(), plan.VersionName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameProjectVersion, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *projectVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state projectVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+
+	// A running model must be stopped before it can be deleted.
+	if state.Running.ValueBool() {
+		if err := stopProjectVersion(ctx, conn, &state, deleteTimeout); err != nil && !tfresource.NotFound(err) {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameProjectVersion, id, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	in := rekognition.DeleteProjectVersionInput{
+		ProjectVersionArn: state.ARN.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteProjectVersion(ctx, &in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameProjectVersion, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitProjectVersionDeleted(ctx, conn, state.ProjectARN.ValueString(), state.VersionName.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForDeletion, ResNameProjectVersion, id, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func startProjectVersion(ctx context.Context, conn *rekognition.Client, data *projectVersionResourceModel, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	in := rekognition.StartProjectVersionInput{
+		MaxInferenceUnits: fwflex.Int32FromFrameworkInt64(ctx, data.MaxInferenceUnits),
+		MinInferenceUnits: fwflex.Int32FromFrameworkInt64(ctx, data.MinInferenceUnits),
+		ProjectVersionArn: data.ARN.ValueStringPointer(),
+	}
+
+	if _, err := conn.StartProjectVersion(ctx, &in); err != nil {
+		return nil, err
+	}
+
+	return waitProjectVersionRunning(ctx, conn, data.ProjectARN.ValueString(), data.VersionName.ValueString(), timeout)
+}
+
+func stopProjectVersion(ctx context.Context, conn *rekognition.Client, data *projectVersionResourceModel, timeout time.Duration) error {
+	in := rekognition.StopProjectVersionInput{
+		ProjectVersionArn: data.ARN.ValueStringPointer(),
+	}
+
+	_, err := conn.StopProjectVersion(ctx, &in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waitProjectVersionStopped(ctx, conn, data.ProjectARN.ValueString(), data.VersionName.ValueString(), timeout)
+
+	return err
+}
+
+func waitProjectVersionTrainingCompleted(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.ProjectVersionStatusTrainingInProgress),
+		Target:         enum.Slice(awstypes.ProjectVersionStatusTrainingCompleted),
+		Refresh:        statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+		Delay:          1 * time.Minute,
+		PollInterval:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionRunning(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ProjectVersionStatusStarting),
+		Target:       enum.Slice(awstypes.ProjectVersionStatusRunning),
+		Refresh:      statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionStopped(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ProjectVersionStatusRunning, awstypes.ProjectVersionStatusStopping),
+		Target:       enum.Slice(awstypes.ProjectVersionStatusStopped),
+		Refresh:      statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionDeleted(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProjectVersionStatusDeleting),
+		Target:  []string{},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Client, projectARN, versionName string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		out, err := findProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findProjectVersionByTwoPartKey(ctx context.Context, conn *rekognition.Client, projectARN, versionName string) (*awstypes.ProjectVersionDescription, error) {
+	in := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   aws.String(projectARN),
+		VersionNames: []string{versionName},
+	}
+
+	out, err := conn.DescribeProjectVersions(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || len(out.ProjectVersionDescriptions) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return tfresource.AssertSingleValueResult(out.ProjectVersionDescriptions)
+}
+
+type projectVersionResourceModel struct {
+	framework.WithRegionModel
+	ARN                types.String                                                     `tfsdk:"arn"`
+	Feature            fwtypes.StringEnum[awstypes.CustomizationFeature]                `tfsdk:"feature"`
+	FeatureConfig      fwtypes.ListNestedObjectValueOf[customizationFeatureConfigModel] `tfsdk:"feature_config"`
+	ID                 types.String                                                     `tfsdk:"id"`
+	KmsKeyID           types.String                                                     `tfsdk:"kms_key_id"`
+	MaxInferenceUnits  types.Int64                                                      `tfsdk:"max_inference_units"`
+	MinInferenceUnits  types.Int64                                                      `tfsdk:"min_inference_units"`
+	OutputConfig       fwtypes.ListNestedObjectValueOf[outputConfigModel]               `tfsdk:"output_config"`
+	ProjectARN         fwtypes.ARN                                                      `tfsdk:"project_arn"`
+	Running            types.Bool                                                       `tfsdk:"running"`
+	Status             fwtypes.StringEnum[awstypes.ProjectVersionStatus]                `tfsdk:"status"`
+	Tags               tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll            tftags.Map                                                       `tfsdk:"tags_all"`
+	TestingData        fwtypes.ListNestedObjectValueOf[testingDataModel]                `tfsdk:"testing_data"`
+	Timeouts           timeouts.Value                                                   `tfsdk:"timeouts"`
+	TrainingData       fwtypes.ListNestedObjectValueOf[trainingDataModel]               `tfsdk:"training_data"`
+	VersionDescription types.String                                                     `tfsdk:"version_description"`
+	VersionName        types.String                                                     `tfsdk:"version_name"`
+}
+
+const (
+	projectVersionResourceIDPartCount = 2
+)
+
+func (m *projectVersionResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), projectVersionResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ProjectARN = fwtypes.ARNValue(parts[0])
+	m.VersionName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *projectVersionResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ProjectARN.ValueString(),
+		m.VersionName.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, projectVersionResourceIDPartCount, false)
+}
+
+func (m *projectVersionResourceModel) flatten(ctx context.Context, v *awstypes.ProjectVersionDescription) (diags diag.Diagnostics) {
+	m.ARN = fwflex.StringToFramework(ctx, v.ProjectVersionArn)
+	m.Feature = fwtypes.StringEnumValue(v.Feature)
+	// The API may return the key ARN for a configured key ID or alias.
+	if m.KmsKeyID.IsNull() || m.KmsKeyID.IsUnknown() {
+		m.KmsKeyID = fwflex.StringToFramework(ctx, v.KmsKeyId)
+	}
+	m.Running = types.BoolValue(v.Status == awstypes.ProjectVersionStatusRunning)
+	m.Status = fwtypes.StringEnumValue(v.Status)
+	m.VersionDescription = fwflex.StringToFramework(ctx, v.VersionDescription)
+
+	// Inference units are only reported while the model is running.
+	if v.Status == awstypes.ProjectVersionStatusRunning {
+		if v.MinInferenceUnits != nil {
+			m.MinInferenceUnits = fwflex.Int32ToFrameworkInt64(ctx, v.MinInferenceUnits)
+		}
+		if v.MaxInferenceUnits != nil {
+			m.MaxInferenceUnits = fwflex.Int32ToFrameworkInt64(ctx, v.MaxInferenceUnits)
+		}
+	}
+
+	diags.Append(fwflex.Flatten(ctx, v.OutputConfig, &m.OutputConfig)...)
+	if v.FeatureConfig != nil && len(m.FeatureConfig.Elements()) > 0 {
+		diags.Append(fwflex.Flatten(ctx, v.FeatureConfig, &m.FeatureConfig)...)
+	}
+
+	return diags
+}
+
+type outputConfigModel struct {
+	S3Bucket    types.String `tfsdk:"s3_bucket"`
+	S3KeyPrefix types.String `tfsdk:"s3_key_prefix"`
+}
+
+type trainingDataModel struct {
+	Assets fwtypes.ListNestedObjectValueOf[assetModel] `tfsdk:"assets"`
+}
+
+type testingDataModel struct {
+	Assets     fwtypes.ListNestedObjectValueOf[assetModel] `tfsdk:"assets"`
+	AutoCreate types.Bool                                  `tfsdk:"auto_create"`
+}
+
+type assetModel struct {
+	GroundTruthManifest fwtypes.ListNestedObjectValueOf[groundTruthManifestModel] `tfsdk:"ground_truth_manifest"`
+}
+
+type groundTruthManifestModel struct {
+	S3Object fwtypes.ListNestedObjectValueOf[s3ObjectModel] `tfsdk:"s3_object"`
+}
+
+type s3ObjectModel struct {
+	Bucket  types.String `tfsdk:"bucket"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+type customizationFeatureConfigModel struct {
+	ContentModeration fwtypes.ListNestedObjectValueOf[customizationFeatureContentModerationConfigModel] `tfsdk:"content_moderation"`
+}
+
+type customizationFeatureContentModerationConfigModel struct {
+	ConfidenceThreshold types.Float64 `tfsdk:"confidence_threshold"`
+}
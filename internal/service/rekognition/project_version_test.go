@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Training a Custom Labels model requires a labeled dataset. Set
+// AWS_REKOGNITION_TRAINING_MANIFEST_BUCKET and AWS_REKOGNITION_TRAINING_MANIFEST_KEY
+// to an existing SageMaker Ground Truth manifest to run these tests.
+
+func TestAccRekognitionProjectVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	manifestBucket := acctest.SkipIfEnvVarNotSet(t, "AWS_REKOGNITION_TRAINING_MANIFEST_BUCKET")
+	manifestKey := acctest.SkipIfEnvVarNotSet(t, "AWS_REKOGNITION_TRAINING_MANIFEST_KEY")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccProjectPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "rekognition", regexache.MustCompile(`project/`+rName+`/version/`+rName+`/\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "feature", "CUSTOM_LABELS"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "running", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "TRAINING_COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"testing_data", "training_data"},
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	manifestBucket := acctest.SkipIfEnvVarNotSet(t, "AWS_REKOGNITION_TRAINING_MANIFEST_BUCKET")
+	manifestKey := acctest.SkipIfEnvVarNotSet(t, "AWS_REKOGNITION_TRAINING_MANIFEST_KEY")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccProjectPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfrekognition.ResourceProjectVersion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_running(t *testing.T) {
+	ctx := acctest.Context(t)
+	manifestBucket := acctest.SkipIfEnvVarNotSet(t, "AWS_REKOGNITION_TRAINING_MANIFEST_BUCKET")
+	manifestKey := acctest.SkipIfEnvVarNotSet(t, "AWS_REKOGNITION_TRAINING_MANIFEST_KEY")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccProjectPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_running(rName, manifestBucket, manifestKey, true, 1, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "running", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "RUNNING"),
+				),
+			},
+			{
+				Config: testAccProjectVersionConfig_running(rName, manifestBucket, manifestKey, true, 2, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "running", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccProjectVersionConfig_running(rName, manifestBucket, manifestKey, false, 2, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "running", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "STOPPED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_project_version" {
+				continue
+			}
+
+			_, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["project_arn"], rs.Primary.Attributes["version_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Rekognition, create.ErrActionCheckingDestroyed, tfrekognition.ResNameProjectVersion, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectVersionExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameProjectVersion, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+
+		_, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["project_arn"], rs.Primary.Attributes["version_name"])
+
+		return err
+	}
+}
+
+func testAccProjectVersionConfig_base(rName, manifestBucket, manifestKey string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project" "test" {
+  name    = %[1]q
+  feature = "CUSTOM_LABELS"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+locals {
+  manifest_bucket = %[2]q
+  manifest_key    = %[3]q
+}
+`, rName, manifestBucket, manifestKey)
+}
+
+func testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey string) string {
+	return acctest.ConfigCompose(testAccProjectVersionConfig_base(rName, manifestBucket, manifestKey), fmt.Sprintf(`
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  output_config {
+    s3_bucket     = aws_s3_bucket.test.bucket
+    s3_key_prefix = "output/"
+  }
+
+  training_data {
+    assets {
+      ground_truth_manifest {
+        s3_object {
+          bucket = local.manifest_bucket
+          name   = local.manifest_key
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName))
+}
+
+func testAccProjectVersionConfig_running(rName, manifestBucket, manifestKey string, running bool, minUnits, maxUnits int) string {
+	return acctest.ConfigCompose(testAccProjectVersionConfig_base(rName, manifestBucket, manifestKey), fmt.Sprintf(`
+resource "aws_rekognition_project_version" "test" {
+  project_arn         = aws_rekognition_project.test.arn
+  version_name        = %[1]q
+  running             = %[2]t
+  min_inference_units = %[3]d
+  max_inference_units = %[4]d
+
+  output_config {
+    s3_bucket     = aws_s3_bucket.test.bucket
+    s3_key_prefix = "output/"
+  }
+
+  training_data {
+    assets {
+      ground_truth_manifest {
+        s3_object {
+          bucket = local.manifest_bucket
+          name   = local.manifest_key
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName, running, minUnits, maxUnits))
+}
@@ -41,6 +41,15 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newProjectVersionResource,
+			TypeName: "aws_rekognition_project_version",
+			Name:     "Project Version",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newStreamProcessorResource,
 			TypeName: "aws_rekognition_stream_processor",
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// ChangeResourceRecordSets request limits.
+// Ref: https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+const (
+	changeBatchMaxChanges         = 1000
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueCharacters = 32000
+)
+
+// resourceRecordSetChanges returns the changes that turn the record sets in have into those in want.
+// Deletions are ordered first so that a record set can be replaced by one of a conflicting type (e.g. A to CNAME).
+func resourceRecordSetChanges(have, want []awstypes.ResourceRecordSet) []awstypes.Change {
+	// Amazon Route 53 can update an existing resource record set only when all
+	// of the following values match: Name, Type and SetIdentifier.
+	// Ref: http://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html.
+	add, remove, modify, _ := intflex.DiffSlicesWithModify(have, want, resourceRecordSetEqual, resourceRecordSetIdentifiersEqual)
+
+	var changes []awstypes.Change
+	for _, r := range remove {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionDelete,
+			ResourceRecordSet: &r,
+		})
+	}
+	for _, r := range add {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionCreate,
+			ResourceRecordSet: &r,
+		})
+	}
+	for _, r := range modify {
+		changes = append(changes, awstypes.Change{
+			Action:            awstypes.ChangeActionUpsert,
+			ResourceRecordSet: &r,
+		})
+	}
+
+	return changes
+}
+
+// chunkChanges splits changes into batches that fit within the ChangeResourceRecordSets request limits.
+// Changes that fit within the limits are returned as a single batch so that they are applied atomically.
+// Otherwise, the changes for a record name are kept in the same batch so that, for example, a deletion
+// and the creation that replaces it are applied together.
+// UPSERT changes count twice towards the resource record and character limits.
+func chunkChanges(changes []awstypes.Change) [][]awstypes.Change {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if n, c := changeBatchSize(changes); len(changes) <= changeBatchMaxChanges && n <= changeBatchMaxResourceRecords && c <= changeBatchMaxValueCharacters {
+		return [][]awstypes.Change{changes}
+	}
+
+	var (
+		groups [][]awstypes.Change
+		index  = make(map[string]int)
+	)
+
+	for _, change := range changes {
+		var name string
+		if v := change.ResourceRecordSet; v != nil {
+			name = normalizeDomainName(v.Name)
+		}
+
+		if i, ok := index[name]; ok {
+			groups[i] = append(groups[i], change)
+		} else {
+			index[name] = len(groups)
+			groups = append(groups, []awstypes.Change{change})
+		}
+	}
+
+	var (
+		batches         [][]awstypes.Change
+		batch           []awstypes.Change
+		resourceRecords int
+		characters      int
+	)
+
+	add := func(changes []awstypes.Change, n, c int) {
+		if len(batch) > 0 && (len(batch)+len(changes) > changeBatchMaxChanges || resourceRecords+n > changeBatchMaxResourceRecords || characters+c > changeBatchMaxValueCharacters) {
+			batches = append(batches, batch)
+			batch, resourceRecords, characters = nil, 0, 0
+		}
+
+		batch = append(batch, changes...)
+		resourceRecords += n
+		characters += c
+	}
+
+	for _, group := range groups {
+		n, c := changeBatchSize(group)
+
+		if len(group) <= changeBatchMaxChanges && n <= changeBatchMaxResourceRecords && c <= changeBatchMaxValueCharacters {
+			add(group, n, c)
+			continue
+		}
+
+		// The group can't be submitted in a single batch.
+		for _, change := range group {
+			n, c := changeBatchSize([]awstypes.Change{change})
+			add([]awstypes.Change{change}, n, c)
+		}
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// changeBatchSize returns the number of resource records and characters that changes count towards the request limits.
+func changeBatchSize(changes []awstypes.Change) (int, int) {
+	var resourceRecords, characters int
+
+	for _, change := range changes {
+		n, c := 0, 0
+		if v := change.ResourceRecordSet; v != nil {
+			n = len(v.ResourceRecords)
+			for _, r := range v.ResourceRecords {
+				c += len(aws.ToString(r.Value))
+			}
+		}
+		if change.Action == awstypes.ChangeActionUpsert {
+			n, c = n*2, c*2
+		}
+
+		resourceRecords += n
+		characters += c
+	}
+
+	return resourceRecords, characters
+}
+
+// changeResourceRecordSets submits changes to the specified hosted zone in as many batches as needed,
+// waiting for each batch to propagate before submitting the next.
+func changeResourceRecordSets(ctx context.Context, conn *route53.Client, zoneID string, changes []awstypes.Change, timeout time.Duration) error {
+	for i, batch := range chunkChanges(changes) {
+		input := route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: batch,
+			},
+		}
+		output, err := conn.ChangeResourceRecordSets(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("changing resource record sets (batch %d): %w", i+1, err)
+		}
+
+		if output == nil || output.ChangeInfo == nil || output.ChangeInfo.Id == nil {
+			return fmt.Errorf("changing resource record sets (batch %d): %w", i+1, errors.New("empty output"))
+		}
+
+		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), timeout); err != nil {
+			return fmt.Errorf("waiting for resource record sets change (%s) synchronization (batch %d): %w", aws.ToString(output.ChangeInfo.Id), i+1, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func Test_chunkChanges(t *testing.T) {
+	t.Parallel()
+
+	named := func(name string, action awstypes.ChangeAction, values ...string) awstypes.Change {
+		v := awstypes.Change{
+			Action: action,
+			ResourceRecordSet: &awstypes.ResourceRecordSet{
+				Name: aws.String(name),
+			},
+		}
+		for _, value := range values {
+			v.ResourceRecordSet.ResourceRecords = append(v.ResourceRecordSet.ResourceRecords, awstypes.ResourceRecord{Value: aws.String(value)})
+		}
+		return v
+	}
+	change := func(action awstypes.ChangeAction, values ...string) awstypes.Change {
+		return named("", action, values...)
+	}
+	repeat := func(n int, v awstypes.Change) []awstypes.Change {
+		s := make([]awstypes.Change, n)
+		for i := range s {
+			s[i] = v
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		changes []awstypes.Change
+		want    []int
+	}{
+		{
+			name:    "empty",
+			changes: nil,
+			want:    nil,
+		},
+		{
+			name:    "single batch",
+			changes: repeat(10, change(awstypes.ChangeActionCreate, "127.0.0.1")),
+			want:    []int{10},
+		},
+		{
+			name:    "resource records limit",
+			changes: repeat(1001, change(awstypes.ChangeActionCreate, "127.0.0.1")),
+			want:    []int{1000, 1},
+		},
+		{
+			name:    "upsert counted twice",
+			changes: repeat(501, change(awstypes.ChangeActionUpsert, "127.0.0.1")),
+			want:    []int{500, 1},
+		},
+		{
+			name:    "alias records count as changes",
+			changes: repeat(1500, change(awstypes.ChangeActionCreate)),
+			want:    []int{1000, 500},
+		},
+		{
+			name:    "characters limit",
+			changes: repeat(5, change(awstypes.ChangeActionCreate, strings.Repeat("a", 4000), strings.Repeat("b", 4000))),
+			want:    []int{4, 1},
+		},
+		{
+			name: "changes for a name kept together",
+			changes: append(
+				repeat(999, named("a.example.com", awstypes.ChangeActionCreate, "127.0.0.1")),
+				named("b.example.com", awstypes.ChangeActionDelete, "127.0.0.1"),
+				named("b.example.com", awstypes.ChangeActionCreate, "example.com"),
+			),
+			want: []int{999, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []int
+			for _, batch := range chunkChanges(tt.changes) {
+				got = append(got, len(batch))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("chunkChanges() batch sizes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route53_records", name="Records")
+func newRecordsResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recordsResource{}
+
+	r.SetDefaultCreateTimeout(45 * time.Minute)
+	r.SetDefaultUpdateTimeout(45 * time.Minute)
+	r.SetDefaultDeleteTimeout(45 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameRecords = "Records"
+)
+
+// recordsResource manages a set of resource record sets in a hosted zone.
+// Unlike aws_route53_records_exclusive, record sets not present in configuration are left untouched.
+type recordsResource struct {
+	framework.ResourceWithModel[recordsResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *recordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_record_set": resourceRecordSetBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// syncRecordSets makes the record sets in the hosted zone that are identified by managed or want match want.
+// Record sets identified only by managed are deleted; all other record sets in the hosted zone are left untouched.
+func (r *recordsResource) syncRecordSets(ctx context.Context, zoneID string, managed, want []awstypes.ResourceRecordSet, timeout time.Duration) error {
+	conn := r.Meta().Route53Client(ctx)
+
+	have, err := findResourceRecordSetsForHostedZone(ctx, conn, zoneID)
+	if err != nil {
+		return err
+	}
+
+	have = filterResourceRecordSetsByIdentifiers(have, slices.Concat(managed, want))
+
+	return changeResourceRecordSets(ctx, conn, zoneID, resourceRecordSetChanges(have, want), timeout)
+}
+
+func (r *recordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan recordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var want []awstypes.ResourceRecordSet
+	resp.Diagnostics.Append(flex.Expand(ctx, plan.ResourceRecordSet, &want)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncRecordSets(ctx, plan.ZoneID.ValueString(), nil, want, r.CreateTimeout(ctx, plan.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecords, plan.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *recordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state recordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed []awstypes.ResourceRecordSet
+	resp.Diagnostics.Append(flex.Expand(ctx, state.ResourceRecordSet, &managed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+	output, err := findResourceRecordSetsForHostedZone(ctx, conn, state.ZoneID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionReading, ResNameRecords, state.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(
+		ctx,
+		struct {
+			ResourceRecordSets []awstypes.ResourceRecordSet
+		}{
+			ResourceRecordSets: filterResourceRecordSetsByIdentifiers(output, managed),
+		},
+		&state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *recordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state recordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed, want []awstypes.ResourceRecordSet
+	resp.Diagnostics.Append(flex.Expand(ctx, state.ResourceRecordSet, &managed)...)
+	resp.Diagnostics.Append(flex.Expand(ctx, plan.ResourceRecordSet, &want)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncRecordSets(ctx, plan.ZoneID.ValueString(), managed, want, r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionUpdating, ResNameRecords, plan.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *recordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state recordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed []awstypes.ResourceRecordSet
+	resp.Diagnostics.Append(flex.Expand(ctx, state.ResourceRecordSet, &managed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRecordSets(ctx, state.ZoneID.ValueString(), managed, nil, r.DeleteTimeout(ctx, state.Timeouts))
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionDeleting, ResNameRecords, state.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+// filterResourceRecordSetsByIdentifiers returns the record sets in s whose identifiers match a record set in ids.
+func filterResourceRecordSetsByIdentifiers(s, ids []awstypes.ResourceRecordSet) []awstypes.ResourceRecordSet {
+	keys := make(map[string]struct{}, len(ids))
+	for _, v := range ids {
+		keys[resourceRecordSetIdentifierKey(v)] = struct{}{}
+	}
+
+	return slices.DeleteFunc(slices.Clone(s), func(v awstypes.ResourceRecordSet) bool {
+		_, ok := keys[resourceRecordSetIdentifierKey(v)]
+		return !ok
+	})
+}
+
+// resourceRecordSetIdentifierKey returns a key that is equal for record sets whose identifiers are equal.
+// See resourceRecordSetIdentifiersEqual.
+func resourceRecordSetIdentifierKey(v awstypes.ResourceRecordSet) string {
+	return strings.Join([]string{dns.Normalize(aws.ToString(v.Name)), string(v.Type), aws.ToString(v.SetIdentifier)}, "|")
+}
+
+type recordsResourceModel struct {
+	ResourceRecordSet fwtypes.SetNestedObjectValueOf[resourceRecordSetModel] `tfsdk:"resource_record_set"`
+	Timeouts          timeouts.Value                                         `tfsdk:"timeouts"`
+	ZoneID            types.String                                           `tfsdk:"zone_id"`
+}
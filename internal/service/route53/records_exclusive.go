@@ -5,7 +5,6 @@ package route53
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -25,7 +24,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -65,199 +63,7 @@ func (r *recordsExclusiveResource) Schema(ctx context.Context, req resource.Sche
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"resource_record_set": schema.SetNestedBlock{
-				CustomType: fwtypes.NewSetNestedObjectTypeOf[resourceRecordSetModel](ctx),
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"failover": schema.StringAttribute{
-							CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetFailover](),
-							Optional:   true,
-						},
-						"health_check_id": schema.StringAttribute{
-							Optional: true,
-						},
-						"multi_value_answer": schema.BoolAttribute{
-							Optional: true,
-						},
-						names.AttrName: schema.StringAttribute{
-							CustomType: fwtypes.DNSNameStringType,
-							Required:   true,
-							Validators: []validator.String{
-								stringvalidator.LengthAtMost(1024),
-							},
-						},
-						names.AttrRegion: schema.StringAttribute{
-							CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetRegion](),
-							Optional:   true,
-						},
-						"set_identifier": schema.StringAttribute{
-							Optional: true,
-							Validators: []validator.String{
-								stringvalidator.LengthBetween(1, 128),
-							},
-						},
-						"traffic_policy_instance_id": schema.StringAttribute{
-							Optional: true,
-							Validators: []validator.String{
-								stringvalidator.LengthBetween(1, 36),
-							},
-						},
-						"ttl": schema.Int64Attribute{
-							Optional: true,
-							Validators: []validator.Int64{
-								int64validator.Between(0, 2147483647),
-							},
-						},
-						names.AttrType: schema.StringAttribute{
-							CustomType: fwtypes.StringEnumType[awstypes.RRType](),
-							Optional:   true,
-						},
-						names.AttrWeight: schema.Int64Attribute{
-							Optional: true,
-							Validators: []validator.Int64{
-								int64validator.Between(0, 255),
-							},
-						},
-					},
-					Blocks: map[string]schema.Block{
-						"alias_target": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[aliasTargetModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									names.AttrDNSName: schema.StringAttribute{
-										CustomType: fwtypes.DNSNameStringType,
-										Required:   true,
-										Validators: []validator.String{
-											stringvalidator.LengthAtMost(1024),
-										},
-									},
-									"evaluate_target_health": schema.BoolAttribute{
-										Required: true,
-									},
-									names.AttrHostedZoneID: schema.StringAttribute{
-										Required: true,
-										Validators: []validator.String{
-											stringvalidator.LengthAtMost(32),
-										},
-									},
-								},
-							},
-						},
-						"cidr_routing_config": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[cidrRoutingConfigModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"collection_id": schema.StringAttribute{
-										Required: true,
-									},
-									"location_name": schema.StringAttribute{
-										Required: true,
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 16),
-										},
-									},
-								},
-							},
-						},
-						"geolocation": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[geoLocationModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"continent_code": schema.StringAttribute{
-										Optional: true,
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(2, 2),
-										},
-									},
-									"country_code": schema.StringAttribute{
-										Optional: true,
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 2),
-										},
-									},
-									"subdivision_code": schema.StringAttribute{
-										Optional: true,
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 3),
-										},
-									},
-								},
-							},
-						},
-						"geoproximity_location": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[geoProximityLocationModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"aws_region": schema.StringAttribute{
-										Optional: true,
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 64),
-										},
-									},
-									"bias": schema.Int64Attribute{
-										Optional: true,
-										Validators: []validator.Int64{
-											int64validator.Between(-99, 99),
-										},
-									},
-									"local_zone_group": schema.StringAttribute{
-										Optional: true,
-										Validators: []validator.String{
-											stringvalidator.LengthBetween(1, 64),
-										},
-									},
-								},
-								Blocks: map[string]schema.Block{
-									"coordinates": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[coordinatesModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
-										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												"latitude": schema.StringAttribute{
-													Required: true,
-												},
-												"longitude": schema.StringAttribute{
-													Required: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"resource_records": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[resourceRecordModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtLeast(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									names.AttrValue: schema.StringAttribute{
-										Required: true,
-										Validators: []validator.String{
-											stringvalidator.LengthAtMost(4000),
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"resource_record_set": resourceRecordSetBlock(ctx),
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -285,61 +91,12 @@ func (r *recordsExclusiveResource) syncRecordSets(ctx context.Context, plan reco
 		return diags
 	}
 
-	// Amazon Route 53 can update an existing resource record set only when all
-	// of the following values match: Name, Type and SetIdentifier.
-	// Ref: http://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html.
-	add, remove, modify, _ := intflex.DiffSlicesWithModify(have, want, resourceRecordSetEqual, resourceRecordSetIdentifiersEqual)
-
-	var changes []awstypes.Change
-	for _, r := range remove {
-		changes = append(changes, awstypes.Change{
-			Action:            awstypes.ChangeActionDelete,
-			ResourceRecordSet: &r,
-		})
-	}
-	for _, r := range add {
-		changes = append(changes, awstypes.Change{
-			Action:            awstypes.ChangeActionCreate,
-			ResourceRecordSet: &r,
-		})
-	}
-	for _, r := range modify {
-		changes = append(changes, awstypes.Change{
-			Action:            awstypes.ChangeActionUpsert,
-			ResourceRecordSet: &r,
-		})
-	}
-
-	if len(changes) > 0 {
-		input := route53.ChangeResourceRecordSetsInput{
-			HostedZoneId: plan.ZoneID.ValueStringPointer(),
-			ChangeBatch: &awstypes.ChangeBatch{
-				Changes: changes,
-			},
-		}
-		out, err := conn.ChangeResourceRecordSets(ctx, &input)
-		if err != nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordsExclusive, plan.ZoneID.String(), err),
-				err.Error(),
-			)
-			return diags
-		}
-		if out == nil || out.ChangeInfo == nil || out.ChangeInfo.Id == nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordsExclusive, plan.ZoneID.String(), nil),
-				errors.New("empty output").Error(),
-			)
-			return diags
-		}
-
-		if _, err := waitChangeInsync(ctx, conn, aws.ToString(out.ChangeInfo.Id), timeout); err != nil {
-			diags.AddError(
-				create.ProblemStandardMessage(names.Route53, create.ErrActionWaitingForCreation, ResNameRecordsExclusive, plan.ZoneID.String(), nil),
-				err.Error(),
-			)
-			return diags
-		}
+	if err := changeResourceRecordSets(ctx, conn, plan.ZoneID.ValueString(), resourceRecordSetChanges(have, want), timeout); err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.Route53, "Syncronizing", ResNameRecordsExclusive, plan.ZoneID.String(), err),
+			err.Error(),
+		)
+		return diags
 	}
 
 	return diags
@@ -416,6 +173,203 @@ func (r *recordsExclusiveResource) ImportState(ctx context.Context, req resource
 	resource.ImportStatePassthroughID(ctx, path.Root("zone_id"), req, resp)
 }
 
+// resourceRecordSetBlock returns the schema for a set of resource record sets.
+func resourceRecordSetBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[resourceRecordSetModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"failover": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetFailover](),
+					Optional:   true,
+				},
+				"health_check_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"multi_value_answer": schema.BoolAttribute{
+					Optional: true,
+				},
+				names.AttrName: schema.StringAttribute{
+					CustomType: fwtypes.DNSNameStringType,
+					Required:   true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtMost(1024),
+					},
+				},
+				names.AttrRegion: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetRegion](),
+					Optional:   true,
+				},
+				"set_identifier": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 128),
+					},
+				},
+				"traffic_policy_instance_id": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 36),
+					},
+				},
+				"ttl": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 2147483647),
+					},
+				},
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+					Optional:   true,
+				},
+				names.AttrWeight: schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 255),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"alias_target": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[aliasTargetModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrDNSName: schema.StringAttribute{
+								CustomType: fwtypes.DNSNameStringType,
+								Required:   true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(1024),
+								},
+							},
+							"evaluate_target_health": schema.BoolAttribute{
+								Required: true,
+							},
+							names.AttrHostedZoneID: schema.StringAttribute{
+								Required: true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(32),
+								},
+							},
+						},
+					},
+				},
+				"cidr_routing_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[cidrRoutingConfigModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"collection_id": schema.StringAttribute{
+								Required: true,
+							},
+							"location_name": schema.StringAttribute{
+								Required: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 16),
+								},
+							},
+						},
+					},
+				},
+				"geolocation": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[geoLocationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"continent_code": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(2, 2),
+								},
+							},
+							"country_code": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 2),
+								},
+							},
+							"subdivision_code": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 3),
+								},
+							},
+						},
+					},
+				},
+				"geoproximity_location": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[geoProximityLocationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"aws_region": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 64),
+								},
+							},
+							"bias": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.Between(-99, 99),
+								},
+							},
+							"local_zone_group": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 64),
+								},
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"coordinates": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[coordinatesModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"latitude": schema.StringAttribute{
+											Required: true,
+										},
+										"longitude": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"resource_records": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[resourceRecordModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrValue: schema.StringAttribute{
+								Required: true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(4000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func findResourceRecordSetsForHostedZone(ctx context.Context, conn *route53.Client, zoneID string) ([]awstypes.ResourceRecordSet, error) {
 	hostedZone, err := findHostedZoneByID(ctx, conn, zoneID)
 	if err != nil {
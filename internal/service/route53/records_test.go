@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_records.test"
+	zoneResourceName := "aws_route53_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 2, "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", zoneResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrType:       string(types.RRTypeA),
+						"ttl":                "30",
+						"resource_records.#": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 2, "60"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"ttl": "60",
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 1, "60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_disappears_Zone(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_records.test"
+	zoneResourceName := "aws_route53_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 1, "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceZone(), zoneResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// Record sets in the hosted zone that are not in configuration are left untouched.
+func TestAccRoute53Records_unmanagedRecords(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_records.test"
+	recordResourceName := "aws_route53_record.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_unmanagedRecords(zoneName.String(), "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					testAccCheckRecordExists(ctx, recordResourceName, new(types.ResourceRecordSet)),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "1"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_unmanagedRecords(zoneName.String(), "60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					testAccCheckRecordExists(ctx, recordResourceName, new(types.ResourceRecordSet)),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// More record sets than fit in a single ChangeResourceRecordSets request.
+func TestAccRoute53Records_batched(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	zoneName := acctest.RandomDomain()
+	resourceName := "aws_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 1500, "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "1500"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 1500, "60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "1500"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			zoneID := rs.Primary.Attributes["zone_id"]
+			_, err := tfroute53.FindResourceRecordSetsForHostedZone(ctx, conn, zoneID)
+			if errs.IsA[*types.NoSuchHostedZone](err) {
+				return nil
+			}
+			if err != nil {
+				return create.Error(names.Route53, create.ErrActionCheckingDestroyed, tfroute53.ResNameRecords, zoneID, err)
+			}
+
+			return create.Error(names.Route53, create.ErrActionCheckingDestroyed, tfroute53.ResNameRecords, zoneID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecords, name, errors.New("not found"))
+		}
+
+		zoneID := rs.Primary.Attributes["zone_id"]
+		if zoneID == "" {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecords, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+		_, err := tfroute53.FindResourceRecordSetsForHostedZone(ctx, conn, zoneID)
+		if err != nil {
+			return create.Error(names.Route53, create.ErrActionCheckingExistence, tfroute53.ResNameRecords, zoneID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsConfig_basic(zoneName string, n int, ttl string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  dynamic "resource_record_set" {
+    for_each = range(%[2]d)
+
+    content {
+      name = "r${resource_record_set.value}.%[1]s"
+      type = "A"
+      ttl  = %[3]q
+
+      resource_records {
+        value = "127.0.${floor(resource_record_set.value / 256)}.${resource_record_set.value %% 256}"
+      }
+    }
+  }
+}
+`, zoneName, n, ttl)
+}
+
+func testAccRecordsConfig_unmanagedRecords(zoneName, ttl string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "unmanaged.%[1]s"
+  type    = "A"
+  ttl     = 30
+  records = ["127.0.0.2"]
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name = "managed.%[1]s"
+    type = "A"
+    ttl  = %[2]q
+
+    resource_records {
+      value = "127.0.0.1"
+    }
+  }
+}
+`, zoneName, ttl)
+}
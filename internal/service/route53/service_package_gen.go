@@ -49,6 +49,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "CIDR Location",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 		},
+		{
+			Factory:  newRecordsResource,
+			TypeName: "aws_route53_records",
+			Name:     "Records",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  newRecordsExclusiveResource,
 			TypeName: "aws_route53_records_exclusive",
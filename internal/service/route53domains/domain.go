@@ -75,6 +75,14 @@ func (r *domainResource) Schema(ctx context.Context, request resource.SchemaRequ
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"auth_code_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
 			"auto_renew": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -290,38 +298,73 @@ func contactDetailBlock(ctx context.Context) schema.Block {
 }
 
 func (r *domainResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
-	var data domainResourceModel
+	var data, config domainResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	conn := r.Meta().Route53DomainsClient(ctx)
 
 	domainName := fwflex.StringValueFromFramework(ctx, data.DomainName)
-	input := &route53domains.RegisterDomainInput{}
-	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
 
-	// Additional fields.
-	input.PrivacyProtectAdminContact = fwflex.BoolFromFramework(ctx, data.AdminPrivacy)
-	input.PrivacyProtectBillingContact = fwflex.BoolFromFramework(ctx, data.BillingPrivacy)
-	input.PrivacyProtectRegistrantContact = fwflex.BoolFromFramework(ctx, data.RegistrantPrivacy)
-	input.PrivacyProtectTechContact = fwflex.BoolFromFramework(ctx, data.TechPrivacy)
+	var operationID string
+	// The write-only authorization code is only in Config, not Plan.
+	if authCode := fwflex.StringValueFromFramework(ctx, config.AuthCodeWO); authCode != "" {
+		// Transfer the domain from another registrar.
+		input := &route53domains.TransferDomainInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 
-	output, err := conn.RegisterDomain(ctx, input)
+		// Additional fields.
+		input.AuthCode = aws.String(authCode)
+		input.PrivacyProtectAdminContact = fwflex.BoolFromFramework(ctx, data.AdminPrivacy)
+		input.PrivacyProtectBillingContact = fwflex.BoolFromFramework(ctx, data.BillingPrivacy)
+		input.PrivacyProtectRegistrantContact = fwflex.BoolFromFramework(ctx, data.RegistrantPrivacy)
+		input.PrivacyProtectTechContact = fwflex.BoolFromFramework(ctx, data.TechPrivacy)
 
-	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 Domains Domain (%s)", domainName), err.Error())
+		output, err := conn.TransferDomain(ctx, input)
 
-		return
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("transferring Route 53 Domains Domain (%s)", domainName), err.Error())
+
+			return
+		}
+
+		operationID = aws.ToString(output.OperationId)
+	} else {
+		input := &route53domains.RegisterDomainInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.PrivacyProtectAdminContact = fwflex.BoolFromFramework(ctx, data.AdminPrivacy)
+		input.PrivacyProtectBillingContact = fwflex.BoolFromFramework(ctx, data.BillingPrivacy)
+		input.PrivacyProtectRegistrantContact = fwflex.BoolFromFramework(ctx, data.RegistrantPrivacy)
+		input.PrivacyProtectTechContact = fwflex.BoolFromFramework(ctx, data.TechPrivacy)
+
+		output, err := conn.RegisterDomain(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 Domains Domain (%s)", domainName), err.Error())
+
+			return
+		}
+
+		operationID = aws.ToString(output.OperationId)
 	}
 
 	response.State.SetAttribute(ctx, path.Root(names.AttrID), data.DomainName) // Set 'id' so as to taint the resource.
 
-	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+	if _, err := waitOperationSucceeded(ctx, conn, operationID, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for Route 53 Domains Domain (%s) create", domainName), err.Error())
 
 		return
@@ -691,6 +734,7 @@ type domainResourceModel struct {
 	AbuseContactPhone types.String                                        `tfsdk:"abuse_contact_phone"`
 	AdminContact      fwtypes.ListNestedObjectValueOf[contactDetailModel] `tfsdk:"admin_contact"`
 	AdminPrivacy      types.Bool                                          `tfsdk:"admin_privacy"`
+	AuthCodeWO        types.String                                        `tfsdk:"auth_code_wo"`
 	AutoRenew         types.Bool                                          `tfsdk:"auto_renew"`
 	BillingContact    fwtypes.ListNestedObjectValueOf[contactDetailModel] `tfsdk:"billing_contact"`
 	BillingPrivacy    types.Bool                                          `tfsdk:"billing_privacy"`
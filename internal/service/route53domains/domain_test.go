@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53domains "github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
@@ -189,6 +190,34 @@ func testAccCheckDomainDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+func testAccDomain_transfer(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53domains_domain.test"
+	domainName := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_TRANSFER_DOMAIN_NAME")
+	authCode := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_TRANSFER_AUTH_CODE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53DomainsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_transfer(domainName, authCode),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("auth_code_wo"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckDomainExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -384,3 +413,61 @@ resource "aws_route53domains_domain" "test" {
 }
 `, domainName, acctest.DefaultEmailAddress, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccDomainConfig_transfer(domainName, authCode string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_domain" "test" {
+  domain_name  = %[1]q
+  auth_code_wo = %[3]q
+
+  admin_contact {
+    address_line_1    = "101 2nd St #700"
+    city              = "San Francisco"
+    contact_type      = "COMPANY"
+    country_code      = "US"
+    email             = %[2]q
+    fax               = "+1.4155551230"
+    first_name        = "Terraform"
+    last_name         = "Team"
+    organization_name = "HashiCorp"
+    phone_number      = "+1.4155551240"
+    state             = "CA"
+    zip_code          = "94105"
+  }
+
+  registrant_contact {
+    address_line_1    = "101 2nd St #702"
+    city              = "San Francisco"
+    contact_type      = "COMPANY"
+    country_code      = "US"
+    email             = %[2]q
+    fax               = "+1.4155551232"
+    first_name        = "Terraform"
+    last_name         = "Team"
+    organization_name = "HashiCorp"
+    phone_number      = "+1.4155551242"
+    state             = "CA"
+    zip_code          = "94105"
+  }
+
+  tech_contact {
+    address_line_1    = "101 2nd St #703"
+    city              = "San Francisco"
+    contact_type      = "COMPANY"
+    country_code      = "US"
+    email             = %[2]q
+    fax               = "+1.4155551233"
+    first_name        = "Terraform"
+    last_name         = "Team"
+    organization_name = "HashiCorp"
+    phone_number      = "+1.4155551243"
+    state             = "CA"
+    zip_code          = "94105"
+  }
+
+  timeouts {
+    create = "168h"
+  }
+}
+`, domainName, acctest.DefaultEmailAddress, authCode)
+}
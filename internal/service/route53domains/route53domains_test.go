@@ -33,6 +33,7 @@ func TestAccRoute53Domains_serial(t *testing.T) {
 			acctest.CtBasic:      testAccDomain_basic,
 			acctest.CtDisappears: testAccDomain_disappears,
 			"tags":               testAccDomain_tags,
+			"transfer":           testAccDomain_transfer,
 		},
 	}
 
@@ -46,7 +46,6 @@ const (
 
 type resourceAssociationResource struct {
 	framework.ResourceWithModel[resourceAssociationResourceModel]
-	framework.WithNoUpdate
 	framework.WithTimeouts
 	framework.WithImportByID
 }
@@ -57,9 +56,6 @@ func (r *resourceAssociationResource) Schema(ctx context.Context, req resource.S
 			names.AttrID: framework.IDAttribute(),
 			names.AttrName: schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			names.AttrOwnerID: schema.StringAttribute{
 				Computed: true,
@@ -83,6 +79,9 @@ func (r *resourceAssociationResource) Schema(ctx context.Context, req resource.S
 			},
 			names.AttrResourceType: schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			names.AttrStatus: schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.ProfileStatus](),
@@ -96,6 +95,7 @@ func (r *resourceAssociationResource) Schema(ctx context.Context, req resource.S
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Read:   true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -182,6 +182,53 @@ func (r *resourceAssociationResource) Read(ctx context.Context, req resource.Rea
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *resourceAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().Route53ProfilesClient(ctx)
+
+	var plan, state resourceAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.ResourceProperties.Equal(state.ResourceProperties) {
+		input := &route53profiles.UpdateProfileResourceAssociationInput{
+			Name:                         plan.Name.ValueStringPointer(),
+			ProfileResourceAssociationId: plan.ID.ValueStringPointer(),
+		}
+		if !plan.ResourceProperties.IsUnknown() {
+			input.ResourceProperties = plan.ResourceProperties.ValueStringPointer()
+		}
+
+		_, err := conn.UpdateProfileResourceAssociation(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53Profiles, create.ErrActionUpdating, ResNameResourceAssociation, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		resourceAssociation, err := waitResourceAssociationUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53Profiles, create.ErrActionWaitingForUpdate, ResNameResourceAssociation, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(flex.Flatten(ctx, resourceAssociation, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
 func (r *resourceAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	conn := r.Meta().Route53ProfilesClient(ctx)
 
@@ -237,6 +284,23 @@ func waitResourceAssociationCreated(ctx context.Context, conn *route53profiles.C
 	return nil, err
 }
 
+func waitResourceAssociationUpdated(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.ProfileResourceAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ProfileStatusUpdating),
+		Target:                    enum.Slice(awstypes.ProfileStatusComplete),
+		Refresh:                   statusResourceAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProfileResourceAssociation); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
 func waitResourceAssociationDeleted(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.ProfileResourceAssociation, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProfileStatusDeleting),
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -81,9 +82,10 @@ func TestAccRoute53ProfilesResourceAssociation_firewallRuleGroup(t *testing.T) {
 		CheckDestroy:             testAccCheckResourceAssociationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceAssociationConfig_firewallRuleGroup(rName),
+				Config: testAccResourceAssociationConfig_firewallRuleGroup(rName, 102),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceAssociationExists(ctx, resourceName, &resourceAssociation),
+					resource.TestCheckResourceAttr(resourceName, "resource_properties", `{"priority":102}`),
 				),
 			},
 			{
@@ -92,6 +94,18 @@ func TestAccRoute53ProfilesResourceAssociation_firewallRuleGroup(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{},
 			},
+			{
+				Config: testAccResourceAssociationConfig_firewallRuleGroup(rName, 103),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAssociationExists(ctx, resourceName, &resourceAssociation),
+					resource.TestCheckResourceAttr(resourceName, "resource_properties", `{"priority":103}`),
+				),
+			},
 		},
 	})
 }
@@ -267,7 +281,7 @@ resource "aws_route53profiles_resource_association" "test" {
 `, rName)
 }
 
-func testAccResourceAssociationConfig_firewallRuleGroup(rName string) string {
+func testAccResourceAssociationConfig_firewallRuleGroup(rName string, priority int) string {
 	return fmt.Sprintf(`
 resource "aws_route53profiles_profile" "test" {
   name = %[1]q
@@ -281,9 +295,9 @@ resource "aws_route53profiles_resource_association" "test" {
   name                = %[1]q
   profile_id          = aws_route53profiles_profile.test.id
   resource_arn        = aws_route53_resolver_firewall_rule_group.test.arn
-  resource_properties = jsonencode({ "priority" = 102 })
+  resource_properties = jsonencode({ "priority" = %[2]d })
 }
-`, rName)
+`, rName, priority)
 }
 
 func testAccResourceAssociationConfig_resolverRule(rName string) string {
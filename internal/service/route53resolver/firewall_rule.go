@@ -63,10 +63,24 @@ func resourceFirewallRule() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.BlockResponse](),
 			},
+			"confidence_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"dns_threat_protection"},
+				ValidateDiagFunc: enum.Validate[awstypes.ConfidenceThreshold](),
+			},
+			"dns_threat_protection": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"dns_threat_protection", "firewall_domain_list_id"},
+				RequiredWith:     []string{"confidence_threshold"},
+				ValidateDiagFunc: enum.Validate[awstypes.DnsThreatProtection](),
+			},
 			"firewall_domain_list_id": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
 			"firewall_domain_redirection_action": {
@@ -81,6 +95,10 @@ func resourceFirewallRule() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"firewall_threat_protection_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -102,15 +120,12 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallDomainListID := d.Get("firewall_domain_list_id").(string)
 	firewallRuleGroupID := d.Get("firewall_rule_group_id").(string)
-	ruleID := firewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID)
 	name := d.Get(names.AttrName).(string)
 	input := &route53resolver.CreateFirewallRuleInput{
 		Action:                          awstypes.Action(d.Get(names.AttrAction).(string)),
 		CreatorRequestId:                aws.String(id.PrefixedUniqueId("tf-r53-resolver-firewall-rule-")),
 		FirewallRuleGroupId:             aws.String(firewallRuleGroupID),
-		FirewallDomainListId:            aws.String(firewallDomainListID),
 		FirewallDomainRedirectionAction: awstypes.FirewallDomainRedirectionAction(d.Get("firewall_domain_redirection_action").(string)),
 		Name:                            aws.String(name),
 		Priority:                        aws.Int32(int32(d.Get(names.AttrPriority).(int))),
@@ -132,17 +147,34 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 		input.BlockResponse = awstypes.BlockResponse(v.(string))
 	}
 
+	if v, ok := d.GetOk("confidence_threshold"); ok {
+		input.ConfidenceThreshold = awstypes.ConfidenceThreshold(v.(string))
+	}
+
+	if v, ok := d.GetOk("dns_threat_protection"); ok {
+		input.DnsThreatProtection = awstypes.DnsThreatProtection(v.(string))
+	}
+
+	if v, ok := d.GetOk("firewall_domain_list_id"); ok {
+		input.FirewallDomainListId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("q_type"); ok {
 		input.Qtype = aws.String(v.(string))
 	}
 
-	_, err := conn.CreateFirewallRule(ctx, input)
+	output, err := conn.CreateFirewallRule(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Route53 Resolver Firewall Rule (%s): %s", name, err)
 	}
 
-	d.SetId(ruleID)
+	// DNS Firewall Advanced rules are identified by their threat protection ID instead of a domain list ID.
+	if v := output.FirewallRule; v != nil && v.FirewallThreatProtectionId != nil {
+		d.SetId(firewallRuleCreateResourceID(firewallRuleGroupID, aws.ToString(v.FirewallThreatProtectionId)))
+	} else {
+		d.SetId(firewallRuleCreateResourceID(firewallRuleGroupID, d.Get("firewall_domain_list_id").(string)))
+	}
 
 	return append(diags, resourceFirewallRuleRead(ctx, d, meta)...)
 }
@@ -151,13 +183,13 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := firewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, firewallDomainListOrThreatProtectionID, err := firewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	firewallRule, err := findFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+	firewallRule, err := findFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListOrThreatProtectionID)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Resolver Firewall Rule (%s) not found, removing from state", d.Id())
@@ -174,9 +206,12 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("block_override_domain", firewallRule.BlockOverrideDomain)
 	d.Set("block_override_ttl", firewallRule.BlockOverrideTtl)
 	d.Set("block_response", firewallRule.BlockResponse)
+	d.Set("confidence_threshold", firewallRule.ConfidenceThreshold)
+	d.Set("dns_threat_protection", firewallRule.DnsThreatProtection)
 	d.Set("firewall_rule_group_id", firewallRule.FirewallRuleGroupId)
 	d.Set("firewall_domain_list_id", firewallRule.FirewallDomainListId)
 	d.Set("firewall_domain_redirection_action", firewallRule.FirewallDomainRedirectionAction)
+	d.Set("firewall_threat_protection_id", firewallRule.FirewallThreatProtectionId)
 	d.Set(names.AttrName, firewallRule.Name)
 	d.Set(names.AttrPriority, firewallRule.Priority)
 	d.Set("q_type", firewallRule.Qtype)
@@ -188,18 +223,17 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := firewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, _, err := firewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	input := &route53resolver.UpdateFirewallRuleInput{
-		Action:               awstypes.Action(d.Get(names.AttrAction).(string)),
-		FirewallDomainListId: aws.String(firewallDomainListID),
-		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
-		Name:                 aws.String(d.Get(names.AttrName).(string)),
-		Priority:             aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+		Action:              awstypes.Action(d.Get(names.AttrAction).(string)),
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+		Name:                aws.String(d.Get(names.AttrName).(string)),
+		Priority:            aws.Int32(int32(d.Get(names.AttrPriority).(int))),
 	}
 
 	if v, ok := d.GetOk("block_override_dns_type"); ok {
@@ -218,10 +252,26 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 		input.BlockResponse = awstypes.BlockResponse(v.(string))
 	}
 
+	if v, ok := d.GetOk("confidence_threshold"); ok {
+		input.ConfidenceThreshold = awstypes.ConfidenceThreshold(v.(string))
+	}
+
+	if v, ok := d.GetOk("dns_threat_protection"); ok {
+		input.DnsThreatProtection = awstypes.DnsThreatProtection(v.(string))
+	}
+
+	if v, ok := d.GetOk("firewall_domain_list_id"); ok {
+		input.FirewallDomainListId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("firewall_domain_redirection_action"); ok {
 		input.FirewallDomainRedirectionAction = awstypes.FirewallDomainRedirectionAction(v.(string))
 	}
 
+	if v, ok := d.GetOk("firewall_threat_protection_id"); ok {
+		input.FirewallThreatProtectionId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("q_type"); ok {
 		input.Qtype = aws.String(v.(string))
 	}
@@ -239,15 +289,22 @@ func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := firewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, _, err := firewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	input := &route53resolver.DeleteFirewallRuleInput{
-		FirewallDomainListId: aws.String(firewallDomainListID),
-		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+	}
+
+	if v, ok := d.GetOk("firewall_domain_list_id"); ok {
+		input.FirewallDomainListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("firewall_threat_protection_id"); ok {
+		input.FirewallThreatProtectionId = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("q_type"); ok {
@@ -281,15 +338,16 @@ func firewallRuleParseResourceID(id string) (string, string, error) {
 	parts := strings.SplitN(id, firewallRuleIDSeparator, 2)
 
 	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id", id, firewallRuleIDSeparator)
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id or firewall_rule_group_id%[2]sfirewall_threat_protection_id", id, firewallRuleIDSeparator)
 	}
 
 	return parts[0], parts[1], nil
 }
 
-func findFirewallRuleByTwoPartKey(ctx context.Context, conn *route53resolver.Client, firewallRuleGroupID, firewallDomainListID string) (*awstypes.FirewallRule, error) {
+// findFirewallRuleByTwoPartKey returns the rule in the rule group that uses the specified domain list or, for DNS Firewall Advanced rules, has the specified threat protection ID.
+func findFirewallRuleByTwoPartKey(ctx context.Context, conn *route53resolver.Client, firewallRuleGroupID, firewallDomainListOrThreatProtectionID string) (*awstypes.FirewallRule, error) {
 	output, err := findFirewallRules(ctx, conn, firewallRuleGroupID, func(rule awstypes.FirewallRule) bool {
-		return aws.ToString(rule.FirewallDomainListId) == firewallDomainListID
+		return aws.ToString(rule.FirewallDomainListId) == firewallDomainListOrThreatProtectionID || aws.ToString(rule.FirewallThreatProtectionId) == firewallDomainListOrThreatProtectionID
 	})
 
 	if err != nil {
@@ -185,6 +185,49 @@ func TestAccRoute53ResolverFirewallRule_qType(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallRule_dnsThreatProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FirewallRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig_dnsThreatProtection(rName, "DGA", "HIGH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "ALERT"),
+					resource.TestCheckResourceAttr(resourceName, "confidence_threshold", "HIGH"),
+					resource.TestCheckResourceAttr(resourceName, "dns_threat_protection", "DGA"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_domain_list_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "firewall_rule_group_id", "aws_route53_resolver_firewall_rule_group.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "firewall_threat_protection_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirewallRuleConfig_dnsThreatProtection(rName, "DGA", "LOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confidence_threshold", "LOW"),
+					resource.TestCheckResourceAttr(resourceName, "dns_threat_protection", "DGA"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.FirewallRule
@@ -378,3 +421,20 @@ resource "aws_route53_resolver_firewall_rule" "test" {
 }
 `, rName, qType)
 }
+
+func testAccFirewallRuleConfig_dnsThreatProtection(rName, dnsThreatProtection, confidenceThreshold string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_rule" "test" {
+  name                   = %[1]q
+  action                 = "ALERT"
+  firewall_rule_group_id = aws_route53_resolver_firewall_rule_group.test.id
+  dns_threat_protection  = %[2]q
+  confidence_threshold   = %[3]q
+  priority               = 100
+}
+`, rName, dnsThreatProtection, confidenceThreshold)
+}
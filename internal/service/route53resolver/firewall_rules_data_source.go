@@ -58,10 +58,18 @@ func dataSourceResolverFirewallRules() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"confidence_threshold": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"creator_request_id": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"dns_threat_protection": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"firewall_domain_list_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -70,6 +78,10 @@ func dataSourceResolverFirewallRules() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"firewall_threat_protection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"modification_time": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -148,6 +160,8 @@ func flattenFirewallRule(apiObject awstypes.FirewallRule) map[string]any {
 		names.AttrAction:          apiObject.Action,
 		"block_override_dns_type": apiObject.BlockOverrideDnsType,
 		"block_response":          apiObject.BlockResponse,
+		"confidence_threshold":    apiObject.ConfidenceThreshold,
+		"dns_threat_protection":   apiObject.DnsThreatProtection,
 	}
 
 	if apiObject.BlockOverrideDomain != nil {
@@ -168,6 +182,9 @@ func flattenFirewallRule(apiObject awstypes.FirewallRule) map[string]any {
 	if apiObject.FirewallRuleGroupId != nil {
 		tfMap["firewall_rule_group_id"] = aws.ToString(apiObject.FirewallRuleGroupId)
 	}
+	if apiObject.FirewallThreatProtectionId != nil {
+		tfMap["firewall_threat_protection_id"] = aws.ToString(apiObject.FirewallThreatProtectionId)
+	}
 	if apiObject.ModificationTime != nil {
 		tfMap["modification_time"] = aws.ToString(apiObject.ModificationTime)
 	}
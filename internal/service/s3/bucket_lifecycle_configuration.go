@@ -60,7 +60,8 @@ func newBucketLifecycleConfigurationResource(context.Context) (resource.Resource
 }
 
 var (
-	_ resource.ResourceWithUpgradeState = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithUpgradeState   = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &bucketLifecycleConfigurationResource{}
 )
 
 type bucketLifecycleConfigurationResource struct {
@@ -599,6 +600,84 @@ func (r *bucketLifecycleConfigurationResource) ImportState(ctx context.Context,
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
 }
 
+// ValidateConfig rejects lifecycle rule elements that directory buckets do not support.
+// Directory buckets only support expiration (by days) and aborting incomplete multipart uploads,
+// filtered by prefix and/or object size.
+func (r *bucketLifecycleConfigurationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data bucketLifecycleConfigurationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Bucket.IsUnknown() || !isDirectoryBucket(data.Bucket.ValueString()) {
+		return
+	}
+
+	if data.Rules.IsUnknown() || data.Rules.IsNull() {
+		return
+	}
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range rules {
+		rulePath := path.Root(names.AttrRule).AtListIndex(i)
+
+		for _, v := range []struct {
+			name  string
+			isSet bool
+		}{
+			{"noncurrent_version_expiration", len(rule.NoncurrentVersionExpirations.Elements()) > 0},
+			{"noncurrent_version_transition", len(rule.NoncurrentVersionTransitions.Elements()) > 0},
+			{"transition", len(rule.Transitions.Elements()) > 0},
+		} {
+			if v.isSet {
+				response.Diagnostics.Append(directoryBucketLifecycleUnsupportedDiag(rulePath.AtName(v.name)))
+			}
+		}
+
+		if !rule.Expiration.IsUnknown() {
+			if expiration, _ := rule.Expiration.ToPtr(ctx); expiration != nil {
+				expirationPath := rulePath.AtName("expiration").AtListIndex(0)
+				if !expiration.Date.IsNull() {
+					response.Diagnostics.Append(directoryBucketLifecycleUnsupportedDiag(expirationPath.AtName("date")))
+				}
+				if expiration.ExpiredObjectDeleteMarker.ValueBool() {
+					response.Diagnostics.Append(directoryBucketLifecycleUnsupportedDiag(expirationPath.AtName("expired_object_delete_marker")))
+				}
+			}
+		}
+
+		if !rule.Filter.IsUnknown() {
+			if filter, _ := rule.Filter.ToPtr(ctx); filter != nil {
+				filterPath := rulePath.AtName(names.AttrFilter).AtListIndex(0)
+				if len(filter.Tag.Elements()) > 0 {
+					response.Diagnostics.Append(directoryBucketLifecycleUnsupportedDiag(filterPath.AtName("tag")))
+				}
+				if !filter.And.IsUnknown() {
+					if and, _ := filter.And.ToPtr(ctx); and != nil && len(and.Tags.Elements()) > 0 {
+						response.Diagnostics.Append(directoryBucketLifecycleUnsupportedDiag(filterPath.AtName("and").AtListIndex(0).AtName(names.AttrTags)))
+					}
+				}
+			}
+		}
+	}
+}
+
+func directoryBucketLifecycleUnsupportedDiag(p path.Path) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Attribute %q is not supported for directory buckets. "+
+			"Directory bucket lifecycle rules support only \"expiration.days\" and \"abort_incomplete_multipart_upload\", "+
+			"filtered by \"prefix\", \"object_size_greater_than\", and \"object_size_less_than\".", p),
+	)
+}
+
 func (r *bucketLifecycleConfigurationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	schemaV0 := bucketLifeCycleConfigurationSchemaV0(ctx)
 
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
@@ -3713,6 +3714,28 @@ func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketLifecycleConfiguration_directoryBucketUnsupported(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDirectoryBucketPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_directoryBucketTransition(rName),
+				ExpectError: regexache.MustCompile(`Attribute "rule\[0\].transition" is not supported for directory buckets`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_directoryBucketFilterTag(rName),
+				ExpectError: regexache.MustCompile(`Attribute "rule\[0\].filter\[0\].tag" is not supported for directory buckets`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLifecycleConfiguration_transitionDefaultMinimumObjectSize_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -5188,6 +5211,64 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 `, rName))
 }
 
+func testAccBucketLifecycleConfigurationConfig_directoryBucketTransition(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_baseAZ(rName), fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_directory_bucket.test.bucket
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {}
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccBucketLifecycleConfigurationConfig_directoryBucketFilterTag(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_baseAZ(rName), fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_directory_bucket.test.bucket
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    expiration {
+      days = 7
+    }
+
+    filter {
+      tag {
+        key   = "Name"
+        value = %[1]q
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccBucketLifecycleConfigurationConfig_transitionDefaultMinimumObjectSize(rName, transitionDefaultMinimumObjectSize string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
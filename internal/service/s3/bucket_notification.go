@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -27,6 +29,7 @@ import (
 
 // @SDKResource("aws_s3_bucket_notification", name="Bucket Notification")
 // @IdentityAttribute("bucket")
+// @CustomImport
 // @Testing(preIdentityVersion="v6.9.0")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/s3;s3.GetBucketNotificationConfigurationOutput")
 func resourceBucketNotification() *schema.Resource {
@@ -36,6 +39,21 @@ func resourceBucketNotification() *schema.Resource {
 		UpdateWithoutTimeout: resourceBucketNotificationPut,
 		DeleteWithoutTimeout: resourceBucketNotificationDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, rd *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				identitySpec := importer.IdentitySpec(ctx)
+
+				if err := importer.RegionalSingleParameterized(ctx, rd, identitySpec, meta.(importer.AWSClient)); err != nil {
+					return nil, err
+				}
+
+				// The bucket's whole notification configuration is imported.
+				rd.Set("merge", false)
+
+				return []*schema.ResourceData{rd}, nil
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrBucket: {
 				Type:     schema.TypeString,
@@ -77,6 +95,11 @@ func resourceBucketNotification() *schema.Resource {
 					},
 				},
 			},
+			"merge": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"queue": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -303,6 +326,38 @@ func resourceBucketNotificationPut(ctx context.Context, d *schema.ResourceData,
 	if len(topicConfigs) > 0 {
 		notificationConfiguration.TopicConfigurations = topicConfigs
 	}
+
+	merge := d.Get("merge").(bool)
+	if merge {
+		// Other resources may own notification configurations on the same bucket.
+		mutexKey := bucketNotificationMutexKey(bucket)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		output, err := tfresource.RetryWhenNewResourceNotFound(ctx, bucketPropagationTimeout, func(ctx context.Context) (*s3.GetBucketNotificationConfigurationOutput, error) {
+			return findBucketNotificationConfiguration(ctx, conn, bucket, "")
+		}, d.IsNewResource())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Notification: %s", bucket, err)
+		}
+
+		// Configurations previously owned by this resource are replaced, all others are kept.
+		ownedIDs := bucketNotificationIDs(d, true)
+		for _, v := range lambdaConfigs {
+			ownedIDs = append(ownedIDs, aws.ToString(v.Id))
+		}
+		for _, v := range queueConfigs {
+			ownedIDs = append(ownedIDs, aws.ToString(v.Id))
+		}
+		for _, v := range topicConfigs {
+			ownedIDs = append(ownedIDs, aws.ToString(v.Id))
+		}
+		o, n := d.GetChange("eventbridge")
+
+		notificationConfiguration = mergeNotificationConfiguration(output, notificationConfiguration, ownedIDs, o.(bool) || n.(bool))
+	}
+
 	input := &s3.PutBucketNotificationConfigurationInput{
 		Bucket:                    aws.String(bucket),
 		NotificationConfiguration: notificationConfiguration,
@@ -320,6 +375,13 @@ func resourceBucketNotificationPut(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Notification: %s", bucket, err)
 	}
 
+	if merge {
+		// Record generated IDs so that Read can identify the configurations owned by this resource.
+		d.Set("lambda_function", flattenLambdaFunctionConfigurations(lambdaConfigs))
+		d.Set("queue", flattenQueueConfigurations(queueConfigs))
+		d.Set("topic", flattenTopicConfigurations(topicConfigs))
+	}
+
 	if d.IsNewResource() {
 		d.SetId(bucket)
 
@@ -356,6 +418,24 @@ func resourceBucketNotificationRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification (%s): %s", d.Id(), err)
 	}
 
+	merge := d.Get("merge").(bool)
+	if merge {
+		// Only report the configurations owned by this resource.
+		ownedIDs := bucketNotificationIDs(d, false)
+		output.LambdaFunctionConfigurations = slices.DeleteFunc(output.LambdaFunctionConfigurations, func(v types.LambdaFunctionConfiguration) bool {
+			return !slices.Contains(ownedIDs, aws.ToString(v.Id))
+		})
+		output.QueueConfigurations = slices.DeleteFunc(output.QueueConfigurations, func(v types.QueueConfiguration) bool {
+			return !slices.Contains(ownedIDs, aws.ToString(v.Id))
+		})
+		output.TopicConfigurations = slices.DeleteFunc(output.TopicConfigurations, func(v types.TopicConfiguration) bool {
+			return !slices.Contains(ownedIDs, aws.ToString(v.Id))
+		})
+		if !d.Get("eventbridge").(bool) {
+			output.EventBridgeConfiguration = nil
+		}
+	}
+
 	d.Set(names.AttrBucket, bucket)
 	d.Set("eventbridge", output.EventBridgeConfiguration != nil)
 	if err := d.Set("lambda_function", flattenLambdaFunctionConfigurations(output.LambdaFunctionConfigurations)); err != nil {
@@ -385,6 +465,25 @@ func resourceBucketNotificationDelete(ctx context.Context, d *schema.ResourceDat
 		NotificationConfiguration: &types.NotificationConfiguration{},
 	}
 
+	if d.Get("merge").(bool) {
+		mutexKey := bucketNotificationMutexKey(bucket)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Notification: %s", bucket, err)
+		}
+
+		// Remove only the configurations owned by this resource.
+		input.NotificationConfiguration = mergeNotificationConfiguration(output, &types.NotificationConfiguration{}, bucketNotificationIDs(d, false), d.Get("eventbridge").(bool))
+	}
+
 	log.Printf("[DEBUG] Deleting S3 Bucket Notification: %s", d.Id())
 	_, err := conn.PutBucketNotificationConfiguration(ctx, input)
 
@@ -429,6 +528,63 @@ func findBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, b
 	return output, nil
 }
 
+func bucketNotificationMutexKey(bucket string) string {
+	return fmt.Sprintf("s3-bucket-notification-%s", bucket)
+}
+
+// bucketNotificationIDs returns the IDs of the configured (or, if old is true, previously configured) notification configurations.
+func bucketNotificationIDs(d *schema.ResourceData, old bool) []string {
+	var ids []string
+
+	for _, k := range []string{"lambda_function", "queue", "topic"} {
+		o, n := d.GetChange(k)
+		v := n
+		if old {
+			v = o
+		}
+
+		for _, tfMapRaw := range v.([]any) {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if id, ok := tfMap[names.AttrID].(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// mergeNotificationConfiguration replaces the notification configurations with the specified IDs in the bucket's
+// current notification configuration by those in owned. The EventBridge configuration is replaced only if ownsEventBridge is true.
+func mergeNotificationConfiguration(current *s3.GetBucketNotificationConfigurationOutput, owned *types.NotificationConfiguration, ownedIDs []string, ownsEventBridge bool) *types.NotificationConfiguration {
+	isOwned := func(id *string) bool {
+		return slices.Contains(ownedIDs, aws.ToString(id))
+	}
+
+	apiObject := &types.NotificationConfiguration{
+		EventBridgeConfiguration: current.EventBridgeConfiguration,
+		LambdaFunctionConfigurations: append(slices.DeleteFunc(slices.Clone(current.LambdaFunctionConfigurations), func(v types.LambdaFunctionConfiguration) bool {
+			return isOwned(v.Id)
+		}), owned.LambdaFunctionConfigurations...),
+		QueueConfigurations: append(slices.DeleteFunc(slices.Clone(current.QueueConfigurations), func(v types.QueueConfiguration) bool {
+			return isOwned(v.Id)
+		}), owned.QueueConfigurations...),
+		TopicConfigurations: append(slices.DeleteFunc(slices.Clone(current.TopicConfigurations), func(v types.TopicConfiguration) bool {
+			return isOwned(v.Id)
+		}), owned.TopicConfigurations...),
+	}
+
+	if ownsEventBridge {
+		apiObject.EventBridgeConfiguration = owned.EventBridgeConfiguration
+	}
+
+	return apiObject
+}
+
 func flattenNotificationConfigurationFilter(filter *types.NotificationConfigurationFilter) map[string]any {
 	filterRules := map[string]any{}
 	if filter.Key == nil || filter.Key.FilterRules == nil {
@@ -252,6 +252,46 @@ func TestAccS3BucketNotification_update(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketNotification_merge(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3.GetBucketNotificationConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification.test"
+	resource2Name := "aws_s3_bucket_notification.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationConfig_merge(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "merge", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "queue.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "topic.0.id", "notification-sns"),
+					resource.TestCheckResourceAttr(resource2Name, "merge", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resource2Name, "queue.#", "1"),
+					resource.TestCheckResourceAttrSet(resource2Name, "queue.0.id"),
+					resource.TestCheckResourceAttr(resource2Name, "topic.#", "0"),
+					testAccCheckBucketNotificationCounts(&v, 1, 1),
+				),
+			},
+			{
+				Config: testAccBucketNotificationConfig_merge(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+					testAccCheckBucketNotificationCounts(&v, 0, 1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketNotification_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -324,6 +364,20 @@ func testAccCheckBucketNotificationExists(ctx context.Context, n string, v *s3.G
 	}
 }
 
+func testAccCheckBucketNotificationCounts(v *s3.GetBucketNotificationConfigurationOutput, queues, topics int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(v.QueueConfigurations); got != queues {
+			return fmt.Errorf("S3 Bucket Notification queue configurations = %d, want %d", got, queues)
+		}
+
+		if got := len(v.TopicConfigurations); got != topics {
+			return fmt.Errorf("S3 Bucket Notification topic configurations = %d, want %d", got, topics)
+		}
+
+		return nil
+	}
+}
+
 func testAccBucketNotificationConfig_eventBridge(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -792,3 +846,88 @@ resource "aws_s3_bucket_notification" "test" {
 }
 `)
 }
+
+func testAccBucketNotificationConfig_merge(rName string, queue bool) string {
+	config := fmt.Sprintf(`
+data "aws_service_principal" "current" {
+  service_name = "s3"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_policy" "test" {
+  arn = aws_sns_topic.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = data.aws_service_principal.current.name }
+      Action    = "SNS:Publish"
+      Resource  = aws_sns_topic.test.arn
+      Condition = {
+        ArnLike = { "aws:SourceArn" = aws_s3_bucket.test.arn }
+      }
+    }]
+  })
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = data.aws_service_principal.current.name }
+      Action    = "sqs:SendMessage"
+      Resource  = aws_sqs_queue.test.arn
+      Condition = {
+        ArnEquals = { "aws:SourceArn" = aws_s3_bucket.test.arn }
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket_notification" "test" {
+  bucket = aws_s3_bucket.test.id
+  merge  = true
+
+  topic {
+    id        = "notification-sns"
+    topic_arn = aws_sns_topic.test.arn
+    events    = ["s3:ObjectCreated:*"]
+  }
+
+  depends_on = [aws_sns_topic_policy.test]
+}
+`, rName)
+
+	if queue {
+		config += `
+resource "aws_s3_bucket_notification" "test2" {
+  bucket = aws_s3_bucket.test.id
+  merge  = true
+
+  queue {
+    queue_arn = aws_sqs_queue.test.arn
+    events    = ["s3:ObjectRemoved:*"]
+  }
+
+  depends_on = [aws_sqs_queue_policy.test]
+}
+`
+	}
+
+	return config
+}
@@ -4,8 +4,10 @@
 package s3
 
 import (
+	"cmp"
 	"context"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -394,7 +396,8 @@ func resourceBucketReplicationConfigurationRead(ctx context.Context, d *schema.R
 
 	d.Set(names.AttrBucket, bucket)
 	d.Set(names.AttrRole, rc.Role)
-	if err := d.Set(names.AttrRule, flattenReplicationRules(ctx, rc.Rules)); err != nil {
+	rules := orderReplicationRulesByID(rc.Rules, d.Get(names.AttrRule).([]any))
+	if err := d.Set(names.AttrRule, flattenReplicationRules(ctx, rules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
 
@@ -422,6 +425,8 @@ func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema
 		input.Token = aws.String(v.(string))
 	}
 
+	// PutBucketReplication replaces the whole configuration in a single request,
+	// so adding, removing or reordering rules never leaves the bucket without rules.
 	_, err := conn.PutBucketReplication(ctx, input)
 
 	if err != nil {
@@ -864,6 +869,41 @@ func expandTag(tfMap map[string]any) *types.Tag {
 	return apiObject
 }
 
+// orderReplicationRulesByID returns the API rules in the order of the rules with matching IDs
+// in tfList, so that S3 returning rules in a different order does not produce a diff.
+// Rules without a match in tfList keep their relative API order and are placed last.
+func orderReplicationRulesByID(apiObjects []types.ReplicationRule, tfList []any) []types.ReplicationRule {
+	positions := make(map[string]int)
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap[names.AttrID].(string); ok && v != "" {
+			positions[v] = i
+		}
+	}
+
+	if len(positions) == 0 {
+		return apiObjects
+	}
+
+	position := func(apiObject types.ReplicationRule) int {
+		if v, ok := positions[aws.ToString(apiObject.ID)]; ok {
+			return v
+		}
+		return len(tfList)
+	}
+
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortStableFunc(apiObjects, func(a, b types.ReplicationRule) int {
+		return cmp.Compare(position(a), position(b))
+	})
+
+	return apiObjects
+}
+
 func flattenReplicationRules(ctx context.Context, apiObjects []types.ReplicationRule) []any {
 	if len(apiObjects) == 0 {
 		return []any{}
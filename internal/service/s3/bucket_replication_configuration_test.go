@@ -6,6 +6,7 @@ package s3_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -239,6 +241,57 @@ func TestAccS3BucketReplicationConfiguration_multipleDestinationsNonEmptyFilter(
 	})
 }
 
+func TestAccS3BucketReplicationConfiguration_ruleOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckWithRegions(testAccCheckBucketReplicationConfigurationDestroyWithRegion(ctx), acctest.Region(), acctest.AlternateRegion()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_ruleOrder(rName, "rule-c", "rule-a", "rule-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "rule-c"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.id", "rule-a"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.id", "rule-b"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccBucketReplicationConfigurationConfig_ruleOrder(rName, "rule-b", "rule-c", "rule-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "rule-b"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.id", "rule-c"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.id", "rule-a"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccS3BucketReplicationConfiguration_twoDestination(t *testing.T) {
 	ctx := acctest.Context(t)
 	// This tests 2 destinations since GovCloud and possibly other non-standard partitions allow a max of 2
@@ -1476,6 +1529,45 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 }`)
 }
 
+func testAccBucketReplicationConfigurationConfig_ruleOrder(rName string, ruleIDs ...string) string {
+	var rules strings.Builder
+	for i, id := range ruleIDs {
+		fmt.Fprintf(&rules, `
+  rule {
+    id       = %[1]q
+    priority = %[2]d
+    status   = "Enabled"
+
+    filter {
+      prefix = %[1]q
+    }
+
+    delete_marker_replication {
+      status = "Disabled"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = "STANDARD"
+    }
+  }
+`, id, i+1)
+	}
+
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+%[1]s
+}
+`, rules.String()))
+}
+
 func testAccBucketReplicationConfigurationConfig_multipleDestinationsEmptyFilter(rName string) string {
 	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), fmt.Sprintf(`
 resource "aws_s3_bucket" "destination2" {
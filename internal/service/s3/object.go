@@ -240,6 +240,16 @@ func resourceObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -575,7 +585,16 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta any)
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	// The upload manager streams the body in parts, so at most upload_concurrency * upload_part_size bytes
+	// are buffered in memory at any one time.
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -602,6 +621,15 @@ func validateMetadataIsLowerCase(v any, k string) (ws []string, errors []error)
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	if hasObjectContentChanges(d) {
+		// The object is re-uploaded, so any stored checksum changes with the new content.
+		if d.Get("checksum_algorithm").(string) != "" {
+			for _, k := range []string{"checksum_crc32", "checksum_crc32c", "checksum_crc64nvme", "checksum_sha1", "checksum_sha256"} {
+				if err := d.SetNewComputed(k); err != nil {
+					return err
+				}
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
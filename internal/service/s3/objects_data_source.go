@@ -5,6 +5,7 @@ package s3
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -49,6 +50,10 @@ func dataSourceObjects() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"include_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -59,6 +64,34 @@ func dataSourceObjects() *schema.Resource {
 				Optional: true,
 				Default:  1000,
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSize: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrStorageClass: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"owners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -136,8 +169,10 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta any
 		input.StartAfter = aws.String(v.(string))
 	}
 
+	includeMetadata := d.Get("include_metadata").(bool)
 	var nKeys int64
 	var commonPrefixes, keys, owners []string
+	var objects []any
 	var requestCharged string
 
 	pages := s3.NewListObjectsV2Paginator(conn, input)
@@ -162,6 +197,10 @@ pageLoop:
 
 			keys = append(keys, aws.ToString(v.Key))
 
+			if includeMetadata {
+				objects = append(objects, flattenObject(v))
+			}
+
 			if v := v.Owner; v != nil {
 				owners = append(owners, aws.ToString(v.ID))
 			}
@@ -173,8 +212,24 @@ pageLoop:
 	d.SetId(bucket)
 	d.Set("common_prefixes", commonPrefixes)
 	d.Set("keys", keys)
+	d.Set("objects", objects)
 	d.Set("owners", owners)
 	d.Set("request_charged", requestCharged)
 
 	return diags
 }
+
+func flattenObject(apiObject types.Object) map[string]any {
+	tfMap := map[string]any{
+		"etag":                 aws.ToString(apiObject.ETag),
+		names.AttrKey:          aws.ToString(apiObject.Key),
+		names.AttrSize:         aws.ToInt64(apiObject.Size),
+		names.AttrStorageClass: string(apiObject.StorageClass),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = v.Format(time.RFC1123)
+	}
+
+	return tfMap
+}
@@ -220,6 +220,36 @@ func TestAccS3ObjectsDataSource_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectsDataSource_includeMetadata(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsDataSourceConfig_includeMetadata(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "prefix1/sub1/0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "26"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.key", "prefix1/sub2/0"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.size", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.2.key", "prefix2/0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectsDataSource_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -382,6 +412,17 @@ data "aws_s3_objects" "test" {
 `)
 }
 
+func testAccObjectsDataSourceConfig_includeMetadata(rName string, n int) string {
+	return acctest.ConfigCompose(testAccObjectsDataSourceConfig_base(rName, n), `
+data "aws_s3_objects" "test" {
+  bucket           = aws_s3_bucket.test.id
+  include_metadata = true
+
+  depends_on = [aws_s3_object.test1, aws_s3_object.test2, aws_s3_object.test3]
+}
+`)
+}
+
 func testAccObjectsDataSourceConfig_directoryBucket(rName string, n int) string {
 	return acctest.ConfigCompose(testAccDirectoryBucketConfig_baseAZ(rName), fmt.Sprintf(`
 resource "aws_s3_directory_bucket" "test" {
@@ -210,7 +210,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrBucket),
 			Import: inttypes.SDKv2Import{
-				WrappedImport: true,
+				CustomImport: true,
 			},
 		},
 		{
@@ -76,6 +76,13 @@ func (r *accessGrantResource) Schema(ctx context.Context, request resource.Schem
 					fwvalidators.AWSAccountID(),
 				},
 			},
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"grant_scope": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -305,6 +312,7 @@ type accessGrantResourceModel struct {
 	AccessGrantsLocationConfiguration fwtypes.ListNestedObjectValueOf[accessGrantsLocationConfigurationModel] `tfsdk:"access_grants_location_configuration"`
 	AccessGrantsLocationID            types.String                                                            `tfsdk:"access_grants_location_id"`
 	AccountID                         types.String                                                            `tfsdk:"account_id"`
+	ApplicationARN                    fwtypes.ARN                                                             `tfsdk:"application_arn"`
 	Grantee                           fwtypes.ListNestedObjectValueOf[granteeModel]                           `tfsdk:"grantee"`
 	GrantScope                        types.String                                                            `tfsdk:"grant_scope"`
 	ID                                types.String                                                            `tfsdk:"id"`
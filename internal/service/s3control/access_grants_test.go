@@ -30,6 +30,7 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			acctest.CtDisappears:    testAccAccessGrant_disappears,
 			"tags":                  testAccAccessGrant_tags,
 			"locationConfiguration": testAccAccessGrant_locationConfiguration,
+			"applicationARN":        testAccAccessGrant_applicationARN,
 		},
 		"InstanceResourcePolicy": {
 			acctest.CtBasic:      testAccAccessGrantsInstanceResourcePolicy_basic,
@@ -5,16 +5,18 @@ package securitylake
 
 // Exports for use in tests only.
 var (
-	ResourceAWSLogSource           = newAWSLogSourceResource
-	ResourceCustomLogSource        = newCustomLogSourceResource
-	ResourceDataLake               = newDataLakeResource
-	ResourceSubscriber             = newSubscriberResource
-	ResourceSubscriberNotification = newSubscriberNotificationResource
+	ResourceAWSLogSource              = newAWSLogSourceResource
+	ResourceCustomLogSource           = newCustomLogSourceResource
+	ResourceDataLake                  = newDataLakeResource
+	ResourceOrganizationConfiguration = newOrganizationConfigurationResource
+	ResourceSubscriber                = newSubscriberResource
+	ResourceSubscriberNotification    = newSubscriberNotificationResource
 
 	FindAWSLogSourceBySourceName             = findAWSLogSourceBySourceName
 	FindCustomLogSourceBySourceName          = findCustomLogSourceBySourceName
 	FindDataLakeByARN                        = findDataLakeByARN
 	FindDataLakes                            = findDataLakes
+	FindOrganizationConfiguration            = findOrganizationConfiguration
 	FindSubscriberByID                       = findSubscriberByID
 	FindSubscriberNotificationBySubscriberID = findSubscriberNotificationBySubscriberID
 )
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_securitylake_organization_configuration", name="Organization Configuration")
+func newOrganizationConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &organizationConfigurationResource{}
+
+	return r, nil
+}
+
+type organizationConfigurationResource struct {
+	framework.ResourceWithModel[organizationConfigurationResourceModel]
+	framework.WithImportByID
+}
+
+func (r *organizationConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"auto_enable_new_account": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[autoEnableNewAccountModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrRegion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"source": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[autoEnableNewAccountSourceModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+										Required:   true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *organizationConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	// The organization configuration is per delegated administrator and Region, so use the Region as the ID.
+	id := r.Meta().Region(ctx)
+	var input securitylake.CreateDataLakeOrganizationConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.CreateDataLakeOrganizationConfigurationOutput, error) {
+		return conn.CreateDataLakeOrganizationConfiguration(ctx, &input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Organization Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	output, err := findOrganizationConfiguration(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Organization Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *organizationConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findOrganizationConfiguration(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var oldInput, newInput securitylake.CreateDataLakeOrganizationConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, old, &oldInput)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &newInput)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	add, del := autoEnableNewAccountDifference(newInput.AutoEnableNewAccount, oldInput.AutoEnableNewAccount), autoEnableNewAccountDifference(oldInput.AutoEnableNewAccount, newInput.AutoEnableNewAccount)
+
+	if err := updateOrganizationConfiguration(ctx, conn, add, del); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := findOrganizationConfiguration(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *organizationConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var input securitylake.DeleteDataLakeOrganizationConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeOrganizationConfigurationOutput, error) {
+		return conn.DeleteDataLakeOrganizationConfiguration(ctx, &input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// updateOrganizationConfiguration applies additions before removals so that new accounts are never left without
+// auto-enabled sources. If removals fail the additions are rolled back, leaving the configuration as it was.
+func updateOrganizationConfiguration(ctx context.Context, conn *securitylake.Client, add, del []awstypes.DataLakeAutoEnableNewAccountConfiguration) error {
+	if len(add) > 0 {
+		input := securitylake.CreateDataLakeOrganizationConfigurationInput{
+			AutoEnableNewAccount: add,
+		}
+
+		_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.CreateDataLakeOrganizationConfigurationOutput, error) {
+			return conn.CreateDataLakeOrganizationConfiguration(ctx, &input)
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding auto-enabled sources: %w", err)
+		}
+	}
+
+	if len(del) > 0 {
+		input := securitylake.DeleteDataLakeOrganizationConfigurationInput{
+			AutoEnableNewAccount: del,
+		}
+
+		_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeOrganizationConfigurationOutput, error) {
+			return conn.DeleteDataLakeOrganizationConfiguration(ctx, &input)
+		})
+
+		if err != nil {
+			err = fmt.Errorf("removing auto-enabled sources: %w", err)
+
+			if len(add) > 0 {
+				input := securitylake.DeleteDataLakeOrganizationConfigurationInput{
+					AutoEnableNewAccount: add,
+				}
+
+				_, rollbackErr := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeOrganizationConfigurationOutput, error) {
+					return conn.DeleteDataLakeOrganizationConfiguration(ctx, &input)
+				})
+
+				if rollbackErr != nil {
+					err = errors.Join(err, fmt.Errorf("rolling back added auto-enabled sources: %w", rollbackErr))
+				}
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// autoEnableNewAccountDifference returns the Region and source pairs in a that are not in b.
+// A source without a version matches any version of the same source in the same Region.
+func autoEnableNewAccountDifference(a, b []awstypes.DataLakeAutoEnableNewAccountConfiguration) []awstypes.DataLakeAutoEnableNewAccountConfiguration {
+	var output []awstypes.DataLakeAutoEnableNewAccountConfiguration
+
+	for _, x := range a {
+		var sources []awstypes.AwsLogSourceResource
+
+		for _, xs := range x.Sources {
+			found := false
+
+		loop:
+			for _, y := range b {
+				if aws.ToString(y.Region) != aws.ToString(x.Region) {
+					continue
+				}
+
+				for _, ys := range y.Sources {
+					if ys.SourceName != xs.SourceName {
+						continue
+					}
+
+					if xv, yv := aws.ToString(xs.SourceVersion), aws.ToString(ys.SourceVersion); xv == "" || yv == "" || xv == yv {
+						found = true
+						break loop
+					}
+				}
+			}
+
+			if !found {
+				sources = append(sources, xs)
+			}
+		}
+
+		if len(sources) > 0 {
+			output = append(output, awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				Region:  x.Region,
+				Sources: sources,
+			})
+		}
+	}
+
+	return output
+}
+
+func findOrganizationConfiguration(ctx context.Context, conn *securitylake.Client) (*securitylake.GetDataLakeOrganizationConfigurationOutput, error) {
+	input := securitylake.GetDataLakeOrganizationConfigurationInput{}
+	output, err := conn.GetDataLakeOrganizationConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AutoEnableNewAccount) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type organizationConfigurationResourceModel struct {
+	framework.WithRegionModel
+	AutoEnableNewAccount fwtypes.SetNestedObjectValueOf[autoEnableNewAccountModel] `tfsdk:"auto_enable_new_account"`
+	ID                   types.String                                              `tfsdk:"id"`
+}
+
+type autoEnableNewAccountModel struct {
+	Region  types.String                                                    `tfsdk:"region"`
+	Sources fwtypes.SetNestedObjectValueOf[autoEnableNewAccountSourceModel] `tfsdk:"source"`
+}
+
+type autoEnableNewAccountSourceModel struct {
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOrganizationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_organization_configuration.test"
+	var v securitylake.GetDataLakeOrganizationConfigurationOutput
+
+	t.Cleanup(func() {
+		testAccDeleteGlueDatabases(ctx, t, acctest.Region())
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.source.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auto_enable_new_account.0.source.*", map[string]string{
+						"source_name": "ROUTE53",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_organization_configuration.test"
+	var v securitylake.GetDataLakeOrganizationConfigurationOutput
+
+	t.Cleanup(func() {
+		testAccDeleteGlueDatabases(ctx, t, acctest.Region())
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecuritylake.ResourceOrganizationConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_organization_configuration.test"
+	var v securitylake.GetDataLakeOrganizationConfigurationOutput
+
+	t.Cleanup(func() {
+		testAccDeleteGlueDatabases(ctx, t, acctest.Region())
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.source.#", "1"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_updated(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.source.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auto_enable_new_account.0.source.*", map[string]string{
+						"source_name": "S3_DATA",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auto_enable_new_account.0.source.*", map[string]string{
+						"source_name": "VPC_FLOW",
+					}),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_organization_configuration" {
+				continue
+			}
+
+			_, err := tfsecuritylake.FindOrganizationConfiguration(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Lake Organization Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationConfigurationExists(ctx context.Context, n string, v *securitylake.GetDataLakeOrganizationConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		output, err := tfsecuritylake.FindOrganizationConfiguration(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOrganizationConfigurationConfig_basic() string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), `
+resource "aws_securitylake_organization_configuration" "test" {
+  auto_enable_new_account {
+    region = data.aws_region.current.region
+
+    source {
+      source_name = "ROUTE53"
+    }
+  }
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+
+data "aws_region" "current" {}
+`)
+}
+
+func testAccOrganizationConfigurationConfig_updated() string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), `
+resource "aws_securitylake_organization_configuration" "test" {
+  auto_enable_new_account {
+    region = data.aws_region.current.region
+
+    source {
+      source_name = "S3_DATA"
+    }
+
+    source {
+      source_name = "VPC_FLOW"
+    }
+  }
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+
+data "aws_region" "current" {}
+`)
+}
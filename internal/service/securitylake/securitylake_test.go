@@ -53,6 +53,11 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"replication":        testAccDataLake_replication,
 			"Identity":           testAccDataLake_IdentitySerial,
 		},
+		"OrganizationConfiguration": {
+			acctest.CtBasic:      testAccOrganizationConfiguration_basic,
+			acctest.CtDisappears: testAccOrganizationConfiguration_disappears,
+			"update":             testAccOrganizationConfiguration_update,
+		},
 		"Subscriber": {
 			"accessType":         testAccSubscriber_accessType,
 			acctest.CtBasic:      testAccSubscriber_basic,
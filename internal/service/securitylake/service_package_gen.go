@@ -48,6 +48,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newOrganizationConfigurationResource,
+			TypeName: "aws_securitylake_organization_configuration",
+			Name:     "Organization Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSubscriberResource,
 			TypeName: "aws_securitylake_subscriber",
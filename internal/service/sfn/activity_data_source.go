@@ -16,10 +16,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKDataSource("aws_sfn_activity", name="Activity")
+// @Tags(identifierAttribute="arn")
 func dataSourceActivity() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceActivityRead,
@@ -38,6 +40,26 @@ func dataSourceActivity() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrEncryptionConfiguration: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_data_key_reuse_period_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrKMSKeyID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,6 +69,7 @@ func dataSourceActivity() *schema.Resource {
 					names.AttrName,
 				},
 			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -55,6 +78,7 @@ func dataSourceActivityRead(ctx context.Context, d *schema.ResourceData, meta an
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
+	var arn string
 	if v, ok := d.GetOk(names.AttrName); ok {
 		name := v.(string)
 
@@ -69,27 +93,29 @@ func dataSourceActivityRead(ctx context.Context, d *schema.ResourceData, meta an
 			return sdkdiag.AppendErrorf(diags, "%d Step Functions Activities matched; use additional constraints to reduce matches to a single Activity", n)
 		}
 
-		activity := output[0]
-
-		arn := aws.ToString(activity.ActivityArn)
-		d.SetId(arn)
-		d.Set(names.AttrARN, arn)
-		d.Set(names.AttrCreationDate, activity.CreationDate.Format(time.RFC3339))
-		d.Set(names.AttrName, activity.Name)
+		arn = aws.ToString(output[0].ActivityArn)
 	} else if v, ok := d.GetOk(names.AttrARN); ok {
-		arn := v.(string)
-		activity, err := findActivityByARN(ctx, conn, arn)
+		arn = v.(string)
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading Step Functions Activity (%s): %s", arn, err)
-		}
+	activity, err := findActivityByARN(ctx, conn, arn)
 
-		arn = aws.ToString(activity.ActivityArn)
-		d.SetId(arn)
-		d.Set(names.AttrARN, arn)
-		d.Set(names.AttrCreationDate, activity.CreationDate.Format(time.RFC3339))
-		d.Set(names.AttrName, activity.Name)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Step Functions Activity (%s): %s", arn, err)
+	}
+
+	arn = aws.ToString(activity.ActivityArn)
+	d.SetId(arn)
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrCreationDate, activity.CreationDate.Format(time.RFC3339))
+	if activity.EncryptionConfiguration != nil {
+		if err := d.Set(names.AttrEncryptionConfiguration, []any{flattenEncryptionConfiguration(activity.EncryptionConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
+		}
+	} else {
+		d.Set(names.AttrEncryptionConfiguration, nil)
 	}
+	d.Set(names.AttrName, activity.Name)
 
 	return diags
 }
@@ -41,6 +41,33 @@ func TestAccSFNActivityDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccSFNActivityDataSource_encryptionAndTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_activity.test"
+	dataSourceName := "data.aws_sfn_activity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityDataSourceConfig_encryptionAndTags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, dataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_configuration.0.kms_key_id", dataSourceName, "encryption_configuration.0.kms_key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.kms_data_key_reuse_period_seconds", "900"),
+					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.type", "CUSTOMER_MANAGED_KMS_KEY"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+		},
+	})
+}
+
 func testAccActivityDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource aws_sfn_activity "test" {
@@ -56,3 +83,31 @@ data aws_sfn_activity "by_arn" {
 }
 `, rName)
 }
+
+func testAccActivityDataSourceConfig_encryptionAndTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_sfn_activity" "test" {
+  name = %[1]q
+
+  encryption_configuration {
+    kms_key_id                        = aws_kms_key.test.arn
+    type                              = "CUSTOMER_MANAGED_KMS_KEY"
+    kms_data_key_reuse_period_seconds = 900
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_sfn_activity" "test" {
+  name = aws_sfn_activity.test.name
+}
+`, rName)
+}
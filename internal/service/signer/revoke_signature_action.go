@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// @Action(aws_signer_revoke_signature, name="Revoke Signature")
+func newRevokeSignatureAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &revokeSignatureAction{}, nil
+}
+
+var (
+	_ action.Action = (*revokeSignatureAction)(nil)
+)
+
+type revokeSignatureAction struct {
+	framework.ActionWithModel[revokeSignatureActionModel]
+}
+
+type revokeSignatureActionModel struct {
+	framework.WithRegionModel
+	JobID    types.String `tfsdk:"job_id"`
+	JobOwner types.String `tfsdk:"job_owner"`
+	Reason   types.String `tfsdk:"reason"`
+}
+
+func (a *revokeSignatureAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Revokes the signature generated by a single AWS Signer signing job.",
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.StringAttribute{
+				Description: "The ID of the signing job to be revoked.",
+				Required:    true,
+			},
+			"job_owner": schema.StringAttribute{
+				Description: "The AWS account ID of the job owner. Required when the signing job was started by another account using a cross-account signing profile permission.",
+				Optional:    true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Description: "The reason for revoking the signing job.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+		},
+	}
+}
+
+func (a *revokeSignatureAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config revokeSignatureActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SignerClient(ctx)
+
+	jobID := config.JobID.ValueString()
+
+	tflog.Info(ctx, "Starting Signer revoke signature action", map[string]any{
+		"job_id": jobID,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Revoking signature for Signer signing job %s...", jobID),
+	})
+
+	var input signer.RevokeSignatureInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input.JobId = aws.String(jobID)
+
+	_, err := conn.RevokeSignature(ctx, &input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Revoke Signer Signature",
+			fmt.Sprintf("Could not revoke signature for Signer signing job %s: %s", jobID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Signature for Signer signing job %s revoked successfully", jobID),
+	})
+
+	tflog.Info(ctx, "Signer revoke signature action completed successfully", map[string]any{
+		"job_id": jobID,
+	})
+}
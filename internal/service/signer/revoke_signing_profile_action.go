@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @Action(aws_signer_revoke_signing_profile, name="Revoke Signing Profile")
+func newRevokeSigningProfileAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &revokeSigningProfileAction{}, nil
+}
+
+var (
+	_ action.Action = (*revokeSigningProfileAction)(nil)
+)
+
+type revokeSigningProfileAction struct {
+	framework.ActionWithModel[revokeSigningProfileActionModel]
+}
+
+type revokeSigningProfileActionModel struct {
+	framework.WithRegionModel
+	EffectiveTime  timetypes.RFC3339 `tfsdk:"effective_time"`
+	ProfileName    types.String      `tfsdk:"profile_name"`
+	ProfileVersion types.String      `tfsdk:"profile_version"`
+	Reason         types.String      `tfsdk:"reason"`
+}
+
+func (a *revokeSigningProfileAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Revokes a version of an AWS Signer signing profile. Signatures generated by signing jobs using the profile version on or after the effective time are no longer valid.",
+		Attributes: map[string]schema.Attribute{
+			"effective_time": schema.StringAttribute{
+				Description: "The RFC3339 timestamp from which signatures generated by the profile version are revoked. Must be no more than 30 days in the past. Defaults to the time the action is invoked.",
+				CustomType:  timetypes.RFC3339Type{},
+				Optional:    true,
+			},
+			"profile_name": schema.StringAttribute{
+				Description: "The name of the signing profile to be revoked.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"profile_version": schema.StringAttribute{
+				Description: "The version of the signing profile to be revoked.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(10, 10),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Description: "The reason for revoking the signing profile.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+		},
+	}
+}
+
+func (a *revokeSigningProfileAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config revokeSigningProfileActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SignerClient(ctx)
+
+	profileName := config.ProfileName.ValueString()
+	profileVersion := config.ProfileVersion.ValueString()
+
+	effectiveTime := time.Now()
+	if !config.EffectiveTime.IsNull() && !config.EffectiveTime.IsUnknown() {
+		v, d := config.EffectiveTime.ValueRFC3339Time()
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		effectiveTime = v
+	}
+
+	tflog.Info(ctx, "Starting Signer revoke signing profile action", map[string]any{
+		"profile_name":    profileName,
+		"profile_version": profileVersion,
+		"effective_time":  effectiveTime.Format(time.RFC3339),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Revoking Signer signing profile %s (version %s) effective from %s...", profileName, profileVersion, effectiveTime.Format(time.RFC3339)),
+	})
+
+	input := signer.RevokeSigningProfileInput{
+		EffectiveTime:  aws.Time(effectiveTime),
+		ProfileName:    aws.String(profileName),
+		ProfileVersion: aws.String(profileVersion),
+		Reason:         config.Reason.ValueStringPointer(),
+	}
+
+	_, err := conn.RevokeSigningProfile(ctx, &input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Revoke Signer Signing Profile",
+			fmt.Sprintf("Could not revoke Signer signing profile %s (version %s): %s", profileName, profileVersion, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Signer signing profile %s (version %s) revoked successfully", profileName, profileVersion),
+	})
+
+	tflog.Info(ctx, "Signer revoke signing profile action completed successfully", map[string]any{
+		"profile_name":    profileName,
+		"profile_version": profileVersion,
+	})
+}
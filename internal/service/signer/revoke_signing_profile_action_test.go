@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsigner "github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+)
+
+func TestAccSignerRevokeSigningProfileAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRevokeSigningProfileActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfileRevoked(ctx, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSigningProfileRevoked(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
+
+		output, err := tfsigner.FindSigningProfileByName(ctx, conn, name)
+
+		if err != nil {
+			return err
+		}
+
+		if output.Status != types.SigningProfileStatusRevoked {
+			return fmt.Errorf("Signer Signing Profile (%s) status is %s, expected %s", name, output.Status, types.SigningProfileStatusRevoked)
+		}
+
+		if output.RevocationRecord == nil {
+			return fmt.Errorf("Signer Signing Profile (%s) has no revocation record", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccRevokeSigningProfileActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name        = %[1]q
+}
+
+action "aws_signer_revoke_signing_profile" "test" {
+  config {
+    profile_name    = aws_signer_signing_profile.test.name
+    profile_version = aws_signer_signing_profile.test.version
+    reason          = "Terraform acceptance test"
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_signer_signing_profile.test.version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_signer_revoke_signing_profile.test]
+    }
+  }
+}
+`, rName)
+}
@@ -137,6 +137,44 @@ func resourceSigningProfile() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"signing_platform_overrides": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"signing_configuration": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.EncryptionAlgorithm](),
+									},
+									"hash_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.HashAlgorithm](),
+									},
+								},
+							},
+						},
+						"signing_image_format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.ImageFormat](),
+						},
+					},
+				},
+			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -186,6 +224,10 @@ func resourceSigningProfileCreate(ctx context.Context, d *schema.ResourceData, m
 		input.SigningParameters = flex.ExpandStringValueMap(v)
 	}
 
+	if v, ok := d.Get("signing_platform_overrides").([]any); ok && len(v) > 0 && v[0] != nil {
+		input.Overrides = expandSigningPlatformOverrides(v[0].(map[string]any))
+	}
+
 	_, err := conn.PutSigningProfile(ctx, input)
 
 	if err != nil {
@@ -241,6 +283,9 @@ func resourceSigningProfileRead(ctx context.Context, d *schema.ResourceData, met
 			return sdkdiag.AppendErrorf(diags, "setting signing_parameters: %s", err)
 		}
 	}
+	if err := d.Set("signing_platform_overrides", flattenSigningPlatformOverrides(output.Overrides)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting signing_platform_overrides: %s", err)
+	}
 	d.Set(names.AttrStatus, output.Status)
 	d.Set(names.AttrVersion, output.ProfileVersion)
 	d.Set("version_arn", output.ProfileVersionArn)
@@ -305,6 +350,61 @@ func flattenSigningMaterial(apiObject *types.SigningMaterial) []any {
 	return []any{m}
 }
 
+func expandSigningPlatformOverrides(tfMap map[string]any) *types.SigningPlatformOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SigningPlatformOverrides{}
+
+	if v, ok := tfMap["signing_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SigningConfiguration = expandSigningConfigurationOverrides(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["signing_image_format"].(string); ok && v != "" {
+		apiObject.SigningImageFormat = types.ImageFormat(v)
+	}
+
+	return apiObject
+}
+
+func expandSigningConfigurationOverrides(tfMap map[string]any) *types.SigningConfigurationOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SigningConfigurationOverrides{}
+
+	if v, ok := tfMap["encryption_algorithm"].(string); ok && v != "" {
+		apiObject.EncryptionAlgorithm = types.EncryptionAlgorithm(v)
+	}
+
+	if v, ok := tfMap["hash_algorithm"].(string); ok && v != "" {
+		apiObject.HashAlgorithm = types.HashAlgorithm(v)
+	}
+
+	return apiObject
+}
+
+func flattenSigningPlatformOverrides(apiObject *types.SigningPlatformOverrides) []any {
+	if apiObject == nil || (apiObject.SigningConfiguration == nil && apiObject.SigningImageFormat == "") {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"signing_image_format": string(apiObject.SigningImageFormat),
+	}
+
+	if v := apiObject.SigningConfiguration; v != nil {
+		tfMap["signing_configuration"] = []any{map[string]any{
+			"encryption_algorithm": string(v.EncryptionAlgorithm),
+			"hash_algorithm":       string(v.HashAlgorithm),
+		}}
+	}
+
+	return []any{tfMap}
+}
+
 func flattenSigningProfileRevocationRecord(apiObject *types.SigningProfileRevocationRecord) any {
 	if apiObject == nil {
 		return []any{}
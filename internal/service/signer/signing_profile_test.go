@@ -232,6 +232,38 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 	})
 }
 
+func TestAccSignerSigningProfile_signingPlatformOverrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf signer.GetSigningProfileOutput
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+	resourceName := "aws_signer_signing_profile.test_sp"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileConfig_signingPlatformOverrides(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfileExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "signing_platform_overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "signing_platform_overrides.0.signing_image_format", "JSONDetached"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSignerSigningProfile_signingParameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
@@ -415,6 +447,19 @@ resource "aws_signer_signing_profile" "test_sp" {
 `, rName)
 }
 
+func testAccSigningProfileConfig_signingPlatformOverrides(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test_sp" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name        = %[1]q
+
+  signing_platform_overrides {
+    signing_image_format = "JSONDetached"
+  }
+}
+`, rName)
+}
+
 func testAccSigningProfileConfig_signingParameters(rName, rootDomain, domainName string) string {
 	return fmt.Sprintf(`
 data "aws_route53_zone" "test" {
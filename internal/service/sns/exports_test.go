@@ -9,6 +9,7 @@ var (
 	ResourceTopic                     = resourceTopic
 	ResourceTopicDataProtectionPolicy = resourceTopicDataProtectionPolicy
 	ResourceTopicPolicy               = resourceTopicPolicy
+	ResourceTopicPolicyStatement      = resourceTopicPolicyStatement
 	ResourceTopicSubscription         = resourceTopicSubscription
 
 	FindDataProtectionPolicyByARN                  = findDataProtectionPolicyByARN
@@ -16,6 +17,7 @@ var (
 	FindSubscriptionAttributesByARN                = findSubscriptionAttributesByARN
 	FindTopicAttributesByARN                       = findTopicAttributesByARN
 	FindTopicAttributesWithValidAWSPrincipalsByARN = findTopicAttributesWithValidAWSPrincipalsByARN // nosemgrep:ci.aws-in-var-name
+	FindTopicPolicyStatementByTwoPartKey           = findTopicPolicyStatementByTwoPartKey
 
 	FIFOTopicNameSuffix                = fifoTopicNameSuffix
 	ParsePlatformApplicationResourceID = parsePlatformApplicationResourceID
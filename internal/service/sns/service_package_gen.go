@@ -103,6 +103,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  resourceTopicPolicyStatement,
+			TypeName: "aws_sns_topic_policy_statement",
+			Name:     "Topic Policy Statement",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceTopicSubscription,
 			TypeName: "aws_sns_topic_subscription",
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sns_topic_policy_statement", name="Topic Policy Statement")
+func resourceTopicPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTopicPolicyStatementPut,
+		ReadWithoutTimeout:   resourceTopicPolicyStatementRead,
+		UpdateWithoutTimeout: resourceTopicPolicyStatementPut,
+		DeleteWithoutTimeout: resourceTopicPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return tfiam.PolicyStatementsEquivalent(old, new)
+				},
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"statement_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens, and underscores"),
+				),
+			},
+		},
+	}
+}
+
+func resourceTopicPolicyStatementPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SNSClient(ctx)
+
+	topicARN, statementID := d.Get(names.AttrARN).(string), d.Get("statement_id").(string)
+	id, err := flex.FlattenResourceId([]string{topicARN, statementID}, topicPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	err = modifyTopicPolicy(ctx, conn, topicARN, func(policy, _ string) (string, error) {
+		return tfiam.PolicyPutStatement(policy, statementID, d.Get("statement").(string))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting SNS Topic Policy Statement (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceTopicPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceTopicPolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SNSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), topicPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	topicARN, statementID := parts[0], parts[1]
+	statement, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func(ctx context.Context) (string, error) {
+		return findTopicPolicyStatementByTwoPartKey(ctx, conn, topicARN, statementID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SNS Topic Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SNS Topic Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, topicARN)
+	d.Set("statement", tfiam.PolicyStatementToSet(d.Get("statement").(string), statement))
+	d.Set("statement_id", statementID)
+
+	return diags
+}
+
+func resourceTopicPolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SNSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), topicPolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	topicARN, statementID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting SNS Topic Policy Statement: %s", d.Id())
+	err = modifyTopicPolicy(ctx, conn, topicARN, func(policy, owner string) (string, error) {
+		policy, n, err := tfiam.PolicyRemoveStatement(policy, statementID)
+		if err != nil {
+			return "", err
+		}
+
+		// A topic policy cannot be removed, so restore the default policy once the last statement is gone.
+		if n == 0 {
+			return defaultTopicPolicy(topicARN, owner), nil
+		}
+
+		return policy, nil
+	})
+
+	if tfresource.NotFound(err) || errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SNS Topic Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const (
+	topicPolicyStatementResourceIDPartCount = 2
+)
+
+// modifyTopicPolicy applies f to the topic's current policy and writes back the result.
+// Modifications to the same topic's policy are serialized.
+func modifyTopicPolicy(ctx context.Context, conn *sns.Client, topicARN string, f func(policy, owner string) (string, error)) error {
+	mutexKey := fmt.Sprintf("sns-topic-policy-%s", topicARN)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	attributes, err := findTopicAttributesByARN(ctx, conn, topicARN)
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := f(attributes[topicAttributeNamePolicy], attributes[topicAttributeNameOwner])
+
+	if err != nil {
+		return err
+	}
+
+	return putTopicPolicy(ctx, conn, topicARN, policy)
+}
+
+func findTopicPolicyStatementByTwoPartKey(ctx context.Context, conn *sns.Client, topicARN, statementID string) (string, error) {
+	attributes, err := findTopicAttributesByARN(ctx, conn, topicARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	return tfiam.PolicyFindStatement(attributes[topicAttributeNamePolicy], statementID)
+}
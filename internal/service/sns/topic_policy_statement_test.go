@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sns_topic_policy_statement.test"
+	resource2Name := "aws_sns_topic_policy_statement.test2"
+	topicResourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicPolicyStatementConfig_basic(rName, "SNS:Publish"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicPolicyStatementExists(ctx, resourceName),
+					testAccCheckTopicPolicyStatementExists(ctx, resource2Name),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, topicResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "Publish"),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile("SNS:Publish")),
+					resource.TestCheckResourceAttr(resource2Name, "statement_id", "Subscribe"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicPolicyStatementConfig_basic(rName, "SNS:GetTopicAttributes"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicPolicyStatementExists(ctx, resourceName),
+					testAccCheckTopicPolicyStatementExists(ctx, resource2Name),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile("SNS:GetTopicAttributes")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sns_topic_policy_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicPolicyStatementConfig_basic(rName, "SNS:Publish"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicPolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsns.ResourceTopicPolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTopicPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sns_topic_policy_statement" {
+				continue
+			}
+
+			_, err := tfsns.FindTopicPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrARN], rs.Primary.Attributes["statement_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SNS Topic Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTopicPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		_, err = tfsns.FindTopicPolicyStatementByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccTopicPolicyStatementConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [policy]
+  }
+}
+
+resource "aws_sns_topic_policy_statement" "test" {
+  arn          = aws_sns_topic.test.arn
+  statement_id = "Publish"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = %[2]q
+    Resource = aws_sns_topic.test.arn
+  })
+}
+
+resource "aws_sns_topic_policy_statement" "test2" {
+  arn          = aws_sns_topic.test.arn
+  statement_id = "Subscribe"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = "SNS:Subscribe"
+    Resource = aws_sns_topic.test.arn
+  })
+}
+`, rName, action)
+}
@@ -7,10 +7,12 @@ package sqs
 var (
 	ResourceQueue                   = resourceQueue
 	ResourceQueuePolicy             = resourceQueuePolicy
+	ResourceQueuePolicyStatement    = resourceQueuePolicyStatement
 	ResourceQueueRedriveAllowPolicy = resourceQueueRedriveAllowPolicy
 	ResourceQueueRedrivePolicy      = resourceQueueRedrivePolicy
 
-	FindQueueAttributesByURL = findQueueAttributesByURL
+	FindQueueAttributesByURL             = findQueueAttributesByURL
+	FindQueuePolicyStatementByTwoPartKey = findQueuePolicyStatementByTwoPartKey
 
 	DefaultQueueDelaySeconds                  = defaultQueueDelaySeconds
 	DefaultQueueKMSDataKeyReusePeriodSeconds  = defaultQueueKMSDataKeyReusePeriodSeconds
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKResource("aws_sqs_queue_policy_statement", name="Queue Policy Statement")
+func resourceQueuePolicyStatement() *schema.Resource {
+	//lintignore:R011
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueuePolicyStatementPut,
+		ReadWithoutTimeout:   resourceQueuePolicyStatementRead,
+		UpdateWithoutTimeout: resourceQueuePolicyStatementPut,
+		DeleteWithoutTimeout: resourceQueuePolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return tfiam.PolicyStatementsEquivalent(old, new)
+				},
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"statement_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens, and underscores"),
+				),
+			},
+		},
+	}
+}
+
+func resourceQueuePolicyStatementPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url, statementID := d.Get("queue_url").(string), d.Get("statement_id").(string)
+	id, err := flex.FlattenResourceId([]string{url, statementID}, queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	err = modifyQueuePolicy(ctx, conn, url, d.Timeout(schema.TimeoutCreate), func(policy string) (string, error) {
+		return tfiam.PolicyPutStatement(policy, statementID, d.Get("statement").(string))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting SQS Queue Policy Statement (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceQueuePolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceQueuePolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	url, statementID := parts[0], parts[1]
+	statement, err := tfresource.RetryWhenNewResourceNotFound(ctx, queueAttributeReadTimeout, func(ctx context.Context) (string, error) {
+		return findQueuePolicyStatementByTwoPartKey(ctx, conn, url, statementID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("queue_url", url)
+	d.Set("statement", tfiam.PolicyStatementToSet(d.Get("statement").(string), statement))
+	d.Set("statement_id", statementID)
+
+	return diags
+}
+
+func resourceQueuePolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	url, statementID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting SQS Queue Policy Statement: %s", d.Id())
+	err = modifyQueuePolicy(ctx, conn, url, d.Timeout(schema.TimeoutDelete), func(policy string) (string, error) {
+		policy, n, err := tfiam.PolicyRemoveStatement(policy, statementID)
+		if err != nil {
+			return "", err
+		}
+
+		// Remove the queue policy altogether once the last statement is gone.
+		if n == 0 {
+			return "", nil
+		}
+
+		return policy, nil
+	})
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const (
+	queuePolicyStatementResourceIDPartCount = 2
+)
+
+// modifyQueuePolicy applies f to the queue's current policy and writes back the result.
+// Modifications to the same queue's policy are serialized and the lock is held until
+// the new policy has propagated so that the next modification reads it.
+func modifyQueuePolicy(ctx context.Context, conn *sqs.Client, url string, timeout time.Duration, f func(string) (string, error)) error {
+	mutexKey := fmt.Sprintf("sqs-queue-policy-%s", url)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	attributes, err := findQueueAttributesByURL(ctx, conn, url)
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := f(attributes[types.QueueAttributeNamePolicy])
+
+	if err != nil {
+		return err
+	}
+
+	attributes = map[types.QueueAttributeName]string{
+		types.QueueAttributeNamePolicy: policy,
+	}
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: flex.ExpandStringyValueMap(attributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	deadline := inttypes.NewDeadline(timeout)
+
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, timeout/2, func(ctx context.Context) (any, error) {
+		return conn.SetQueueAttributes(ctx, input)
+	}, errCodeInvalidAttributeValue, "Invalid value for the parameter Policy")
+
+	if err != nil {
+		return err
+	}
+
+	return waitQueueAttributesPropagated(ctx, conn, url, attributes, deadline.Remaining())
+}
+
+func findQueuePolicyStatementByTwoPartKey(ctx context.Context, conn *sqs.Client, url, statementID string) (string, error) {
+	policy, err := findQueueAttributeByTwoPartKey(ctx, conn, url, types.QueueAttributeNamePolicy)
+
+	if err != nil {
+		return "", err
+	}
+
+	return tfiam.PolicyFindStatement(aws.ToString(policy), statementID)
+}
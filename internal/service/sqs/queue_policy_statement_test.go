@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSQueuePolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	resource2Name := "aws_sqs_queue_policy_statement.test2"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName, "sqs:SendMessage"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					testAccCheckQueuePolicyStatementExists(ctx, resource2Name),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", queueResourceName, names.AttrURL),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "Send"),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile("sqs:SendMessage")),
+					resource.TestCheckResourceAttr(resource2Name, "statement_id", "Receive"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName, "sqs:GetQueueAttributes"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					testAccCheckQueuePolicyStatementExists(ctx, resource2Name),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile("sqs:GetQueueAttributes")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName, "sqs:SendMessage"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsqs.ResourceQueuePolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_sid(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_sid(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "Send"),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile(`"Sid":"Send"`)),
+				),
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_sid(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckQueuePolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue_policy_statement" {
+				continue
+			}
+
+			_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["statement_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SQS Queue Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQueuePolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["statement_id"])
+
+		return err
+	}
+}
+
+func testAccQueuePolicyStatementConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [policy]
+  }
+}
+
+resource "aws_sqs_queue_policy_statement" "test" {
+  queue_url    = aws_sqs_queue.test.id
+  statement_id = "Send"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = %[2]q
+    Resource = aws_sqs_queue.test.arn
+  })
+}
+
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url    = aws_sqs_queue.test.id
+  statement_id = "Receive"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = "sqs:ReceiveMessage"
+    Resource = aws_sqs_queue.test.arn
+  })
+}
+`, rName, action)
+}
+
+func testAccQueuePolicyStatementConfig_sid(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [policy]
+  }
+}
+
+resource "aws_sqs_queue_policy_statement" "test" {
+  queue_url    = aws_sqs_queue.test.id
+  statement_id = "Send"
+
+  statement = jsonencode({
+    Sid    = "Send"
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = "sqs:SendMessage"
+    Resource = aws_sqs_queue.test.arn
+  })
+}
+`, rName)
+}
@@ -94,6 +94,10 @@ func resourceDefaultPatchBaselineCreate(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "Patch Baseline Operating System (%s) does not match %s", pbOS, cOS)
 	}
 
+	mutexKey := defaultPatchBaselineMutexKey(patchBaseline.OperatingSystem)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	input := &ssm.RegisterDefaultPatchBaselineInput{
 		BaselineId: aws.String(baselineID),
 	}
@@ -132,7 +136,37 @@ func resourceDefaultPatchBaselineRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDefaultPatchBaselineDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return defaultPatchBaselineRestoreOSDefault(ctx, meta.(*conns.AWSClient).SSMClient(ctx), awstypes.OperatingSystem(d.Id()))
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	os := awstypes.OperatingSystem(d.Id())
+	mutexKey := defaultPatchBaselineMutexKey(os)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := findDefaultPatchBaselineByOperatingSystem(ctx, conn, os)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Default Patch Baseline (%s): %s", d.Id(), err)
+	}
+
+	// Another resource may have registered a different default for this operating system
+	// since this one was created, e.g. when the baseline is replaced using create_before_destroy.
+	// Don't clobber it by restoring the AWS-owned default.
+	if current, old := normalizePatchBaselineID(aws.ToString(output.BaselineId)), normalizePatchBaselineID(d.Get("baseline_id").(string)); current != old {
+		log.Printf("[WARN] SSM Default Patch Baseline (%s) is now %s, not %s; not restoring the AWS-owned default", d.Id(), current, old)
+		return diags
+	}
+
+	return append(diags, defaultPatchBaselineRestoreOSDefault(ctx, conn, os)...)
+}
+
+func defaultPatchBaselineMutexKey(os awstypes.OperatingSystem) string {
+	return fmt.Sprintf("ssm-default-patch-baseline-%s", os)
 }
 
 func defaultPatchBaselineRestoreOSDefault(ctx context.Context, conn *ssm.Client, os awstypes.OperatingSystem) diag.Diagnostics {
@@ -284,6 +318,14 @@ func validatePatchBaselineARN(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+func normalizePatchBaselineID(s string) string {
+	if arn.IsARN(s) {
+		return patchBaselineIDFromARN(s)
+	}
+
+	return s
+}
+
 func isPatchBaselineID(s string) bool {
 	re := regexache.MustCompile(`^` + patchBaselineIDRegexPattern + `$`)
 
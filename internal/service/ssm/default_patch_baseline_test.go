@@ -284,6 +284,49 @@ func testAccSSMDefaultPatchBaseline_update(t *testing.T) {
 	})
 }
 
+func testAccSSMDefaultPatchBaseline_createBeforeDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ssm.GetDefaultPatchBaselineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_default_patch_baseline.test"
+	baselineResourceName := "aws_ssm_patch_baseline.test"
+	baselineUpdatedResourceName := "aws_ssm_patch_baseline.updated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultPatchBaselineConfig_createBeforeDestroy(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultPatchBaselineExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "baseline_id", baselineResourceName, names.AttrID),
+				),
+			},
+			{
+				// Destroying the replaced resource must not restore the AWS-owned default.
+				Config: testAccDefaultPatchBaselineConfig_createBeforeDestroy(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultPatchBaselineExists(ctx, resourceName, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "baseline_id", baselineUpdatedResourceName, names.AttrID),
+					func(s *terraform.State) error {
+						if got, want := aws.ToString(v2.BaselineId), s.RootModule().Resources[baselineUpdatedResourceName].Primary.ID; got != want {
+							return fmt.Errorf("default patch baseline is %s, want %s", got, want)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccSSMDefaultPatchBaseline_multiRegion(t *testing.T) {
 	ctx := acctest.Context(t)
 	var main, alternate ssm.GetDefaultPatchBaselineOutput
@@ -496,6 +539,35 @@ resource "aws_ssm_patch_baseline" "updated" {
 `, rName, os)
 }
 
+func testAccDefaultPatchBaselineConfig_createBeforeDestroy(rName, baseline string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_default_patch_baseline" "test" {
+  baseline_id      = aws_ssm_patch_baseline.%[2]s.id
+  operating_system = aws_ssm_patch_baseline.%[2]s.operating_system
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "WINDOWS"
+
+  approved_patches                  = ["KB123456"]
+  approved_patches_compliance_level = "CRITICAL"
+}
+
+resource "aws_ssm_patch_baseline" "updated" {
+  name             = "%[1]s-updated"
+  operating_system = "WINDOWS"
+
+  approved_patches                  = ["KB123456"]
+  approved_patches_compliance_level = "CRITICAL"
+}
+`, rName, baseline)
+}
+
 func testAccDefaultPatchBaselineConfig_multiRegion(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(2),
@@ -434,7 +434,11 @@ func resourcePatchBaselineDelete(ctx context.Context, d *schema.ResourceData, me
 
 	if errs.IsA[*awstypes.ResourceInUseException](err) {
 		// Reset the default patch baseline before retrying.
-		diags = append(diags, defaultPatchBaselineRestoreOSDefault(ctx, meta.(*conns.AWSClient).SSMClient(ctx), awstypes.OperatingSystem(d.Get("operating_system").(string)))...)
+		os := awstypes.OperatingSystem(d.Get("operating_system").(string))
+		mutexKey := defaultPatchBaselineMutexKey(os)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		diags = append(diags, defaultPatchBaselineRestoreOSDefault(ctx, conn, os)...)
+		conns.GlobalMutexKV.Unlock(mutexKey)
 		if diags.HasError() {
 			return diags
 		}
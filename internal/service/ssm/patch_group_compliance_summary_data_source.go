@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_ssm_patch_group_compliance_summary", name="Patch Group Compliance Summary")
+func newPatchGroupComplianceSummaryDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &patchGroupComplianceSummaryDataSource{}, nil
+}
+
+type patchGroupComplianceSummaryDataSource struct {
+	framework.DataSourceWithModel[patchGroupComplianceSummaryDataSourceModel]
+}
+
+func (d *patchGroupComplianceSummaryDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"baseline_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"instances": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_available_security_updates": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_critical_non_compliant_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_failed_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_installed_other_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_installed_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_installed_pending_reboot_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_installed_rejected_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_missing_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_not_applicable_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_other_non_compliant_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_security_non_compliant_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"instances_with_unreported_not_applicable_patches": schema.Int64Attribute{
+				Computed: true,
+			},
+			"operating_system": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OperatingSystem](),
+				Optional:   true,
+				Computed:   true,
+			},
+			"patch_group": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *patchGroupComplianceSummaryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data patchGroupComplianceSummaryDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSMClient(ctx)
+
+	patchGroup := data.PatchGroup.ValueString()
+	baselineInput := ssm.GetPatchBaselineForPatchGroupInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &baselineInput)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	baseline, err := conn.GetPatchBaselineForPatchGroup(ctx, &baselineInput)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Patch Baseline for Patch Group (%s)", patchGroup), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, baseline, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	stateInput := ssm.DescribePatchGroupStateInput{
+		PatchGroup: aws.String(patchGroup),
+	}
+
+	state, err := conn.DescribePatchGroupState(ctx, &stateInput)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Patch Group (%s) state", patchGroup), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, state, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type patchGroupComplianceSummaryDataSourceModel struct {
+	framework.WithRegionModel
+	BaselineID                                  types.String                                 `tfsdk:"baseline_id"`
+	Instances                                   types.Int64                                  `tfsdk:"instances"`
+	InstancesWithAvailableSecurityUpdates       types.Int64                                  `tfsdk:"instances_with_available_security_updates"`
+	InstancesWithCriticalNonCompliantPatches    types.Int64                                  `tfsdk:"instances_with_critical_non_compliant_patches"`
+	InstancesWithFailedPatches                  types.Int64                                  `tfsdk:"instances_with_failed_patches"`
+	InstancesWithInstalledOtherPatches          types.Int64                                  `tfsdk:"instances_with_installed_other_patches"`
+	InstancesWithInstalledPatches               types.Int64                                  `tfsdk:"instances_with_installed_patches"`
+	InstancesWithInstalledPendingRebootPatches  types.Int64                                  `tfsdk:"instances_with_installed_pending_reboot_patches"`
+	InstancesWithInstalledRejectedPatches       types.Int64                                  `tfsdk:"instances_with_installed_rejected_patches"`
+	InstancesWithMissingPatches                 types.Int64                                  `tfsdk:"instances_with_missing_patches"`
+	InstancesWithNotApplicablePatches           types.Int64                                  `tfsdk:"instances_with_not_applicable_patches"`
+	InstancesWithOtherNonCompliantPatches       types.Int64                                  `tfsdk:"instances_with_other_non_compliant_patches"`
+	InstancesWithSecurityNonCompliantPatches    types.Int64                                  `tfsdk:"instances_with_security_non_compliant_patches"`
+	InstancesWithUnreportedNotApplicablePatches types.Int64                                  `tfsdk:"instances_with_unreported_not_applicable_patches"`
+	OperatingSystem                             fwtypes.StringEnum[awstypes.OperatingSystem] `tfsdk:"operating_system"`
+	PatchGroup                                  types.String                                 `tfsdk:"patch_group"`
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMPatchGroupComplianceSummaryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssm_patch_group_compliance_summary.test"
+	baselineResourceName := "aws_ssm_patch_baseline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchGroupComplianceSummaryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_id", baselineResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "instances", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_failed_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_missing_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "operating_system", "AMAZON_LINUX_2023"),
+					resource.TestCheckResourceAttr(dataSourceName, "patch_group", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatchGroupComplianceSummaryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "AMAZON_LINUX_2023"
+
+  approval_rule {
+    approve_after_days = 7
+
+    patch_filter {
+      key    = "CLASSIFICATION"
+      values = ["Security"]
+    }
+  }
+}
+
+resource "aws_ssm_patch_group" "test" {
+  baseline_id = aws_ssm_patch_baseline.test.id
+  patch_group = %[1]q
+}
+
+data "aws_ssm_patch_group_compliance_summary" "test" {
+  patch_group      = aws_ssm_patch_group.test.patch_group
+  operating_system = aws_ssm_patch_baseline.test.operating_system
+}
+`, rName)
+}
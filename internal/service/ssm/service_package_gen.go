@@ -36,6 +36,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Patch Baselines",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newPatchGroupComplianceSummaryDataSource,
+			TypeName: "aws_ssm_patch_group_compliance_summary",
+			Name:     "Patch Group Compliance Summary",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
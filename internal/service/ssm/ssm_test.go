@@ -17,6 +17,7 @@ func TestAccSSM_serial(t *testing.T) {
 		"DefaultPatchBaseline": {
 			acctest.CtBasic:        testAccSSMDefaultPatchBaseline_basic,
 			acctest.CtDisappears:   testAccSSMDefaultPatchBaseline_disappears,
+			"createBeforeDestroy":  testAccSSMDefaultPatchBaseline_createBeforeDestroy,
 			"otherOperatingSystem": testAccSSMDefaultPatchBaseline_otherOperatingSystem,
 			"patchBaselineARN":     testAccSSMDefaultPatchBaseline_patchBaselineARN,
 			"systemDefault":        testAccSSMDefaultPatchBaseline_systemDefault,
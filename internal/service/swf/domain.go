@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -61,6 +62,12 @@ func resourceDomain() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{names.AttrName},
 			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.RegistrationStatus](),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"workflow_execution_retention_period_in_days": {
@@ -103,6 +110,12 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	d.SetId(name)
 
+	if v, ok := d.GetOk(names.AttrStatus); ok && types.RegistrationStatus(v.(string)) == types.RegistrationStatusDeprecated {
+		if err := deprecateDomain(ctx, conn, name); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
@@ -110,7 +123,7 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SWFClient(ctx)
 
-	output, err := findDomainByName(ctx, conn, d.Id())
+	output, err := findDomain(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && retry.NotFound(err) {
 		log.Printf("[WARN] SWF Domain (%s) not found, removing from state", d.Id())
@@ -127,14 +140,31 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set(names.AttrDescription, output.DomainInfo.Description)
 	d.Set(names.AttrName, output.DomainInfo.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(output.DomainInfo.Name)))
+	d.Set(names.AttrStatus, output.DomainInfo.Status)
 	d.Set("workflow_execution_retention_period_in_days", output.Configuration.WorkflowExecutionRetentionPeriodInDays)
 
 	return diags
 }
 
 func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	// Tags only.
-	return resourceDomainRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	if d.HasChange(names.AttrStatus) {
+		var err error
+		switch types.RegistrationStatus(d.Get(names.AttrStatus).(string)) {
+		case types.RegistrationStatusDeprecated:
+			err = deprecateDomain(ctx, conn, d.Id())
+		case types.RegistrationStatusRegistered:
+			err = undeprecateDomain(ctx, conn, d.Id())
+		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
 func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -156,7 +186,57 @@ func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return diags
 }
 
+func deprecateDomain(ctx context.Context, conn *swf.Client, name string) error {
+	_, err := conn.DeprecateDomain(ctx, &swf.DeprecateDomainInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsA[*types.DomainDeprecatedFault](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deprecating SWF Domain (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func undeprecateDomain(ctx context.Context, conn *swf.Client, name string) error {
+	_, err := conn.UndeprecateDomain(ctx, &swf.UndeprecateDomainInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsA[*types.DomainAlreadyExistsFault](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("undeprecating SWF Domain (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+// findDomainByName returns the specified domain if it is registered.
 func findDomainByName(ctx context.Context, conn *swf.Client, name string) (*swf.DescribeDomainOutput, error) {
+	output, err := findDomain(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.DomainInfo.Status; status == types.RegistrationStatusDeprecated {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
+
+// findDomain returns the specified domain, whether registered or deprecated.
+func findDomain(ctx context.Context, conn *swf.Client, name string) (*swf.DescribeDomainOutput, error) {
 	input := &swf.DescribeDomainInput{
 		Name: aws.String(name),
 	}
@@ -177,11 +257,5 @@ func findDomainByName(ctx context.Context, conn *swf.Client, name string) (*swf.
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	if status := output.DomainInfo.Status; status == types.RegistrationStatusDeprecated {
-		return nil, &retry.NotFoundError{
-			Message: string(status),
-		}
-	}
-
 	return output, nil
 }
@@ -51,6 +51,7 @@ func TestAccSWFDomain_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 					resource.TestCheckResourceAttr(resourceName, "workflow_execution_retention_period_in_days", "1"),
 				),
@@ -228,6 +229,50 @@ func TestAccSWFDomain_description(t *testing.T) {
 	})
 }
 
+func TestAccSWFDomain_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_swf_domain.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_status(rName, "DEPRECATED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEPRECATED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfig_status(rName, "REGISTERED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
+				),
+			},
+			{
+				Config: testAccDomainConfig_status(rName, "DEPRECATED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEPRECATED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDomainDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).SWFClient(ctx)
@@ -262,7 +307,7 @@ func testAccCheckDomainExists(ctx context.Context, t *testing.T, n string) resou
 
 		conn := acctest.ProviderMeta(ctx, t).SWFClient(ctx)
 
-		_, err := tfswf.FindDomainByName(ctx, conn, rs.Primary.ID)
+		_, err := tfswf.FindDomain(ctx, conn, rs.Primary.ID)
 
 		return err
 	}
@@ -330,3 +375,13 @@ resource "aws_swf_domain" "test" {
 }
 `, rName, description)
 }
+
+func testAccDomainConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  status                                      = %[2]q
+  workflow_execution_retention_period_in_days = 1
+}
+`, rName, status)
+}
@@ -5,6 +5,7 @@ package swf
 
 // Exports for use in tests only.
 var (
+	FindDomain       = findDomain
 	FindDomainByName = findDomainByName
 
 	ResourceDomain = resourceDomain
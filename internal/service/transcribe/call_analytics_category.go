@@ -0,0 +1,589 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transcribe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfobjectvalidator "github.com/hashicorp/terraform-provider-aws/internal/framework/validators/objectvalidator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_transcribe_call_analytics_category", name="Call Analytics Category")
+func newCallAnalyticsCategoryResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &callAnalyticsCategoryResource{}
+
+	return r, nil
+}
+
+type callAnalyticsCategoryResource struct {
+	framework.ResourceWithModel[callAnalyticsCategoryResourceModel]
+	framework.WithImportByID
+}
+
+func (r *callAnalyticsCategoryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	participantRoleAttribute := schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.ParticipantRole](),
+		Optional:   true,
+	}
+	negateAttribute := schema.BoolAttribute{
+		Optional: true,
+	}
+	thresholdAttribute := schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"category_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"input_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InputType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ruleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Validators: []validator.Object{
+						tfobjectvalidator.ExactlyOneOfChildren(
+							path.MatchRelative().AtName("interruption_filter"),
+							path.MatchRelative().AtName("non_talk_time_filter"),
+							path.MatchRelative().AtName("sentiment_filter"),
+							path.MatchRelative().AtName("transcript_filter"),
+						),
+					},
+					Blocks: map[string]schema.Block{
+						"interruption_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[interruptionFilterModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Validators: timeRangeValidators(),
+								Attributes: map[string]schema.Attribute{
+									"negate":           negateAttribute,
+									"participant_role": participantRoleAttribute,
+									"threshold":        thresholdAttribute,
+								},
+								Blocks: timeRangeBlocks(ctx),
+							},
+						},
+						"non_talk_time_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[nonTalkTimeFilterModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Validators: timeRangeValidators(),
+								Attributes: map[string]schema.Attribute{
+									"negate":    negateAttribute,
+									"threshold": thresholdAttribute,
+								},
+								Blocks: timeRangeBlocks(ctx),
+							},
+						},
+						"sentiment_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sentimentFilterModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Validators: timeRangeValidators(),
+								Attributes: map[string]schema.Attribute{
+									"negate":           negateAttribute,
+									"participant_role": participantRoleAttribute,
+									"sentiments": schema.SetAttribute{
+										CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.SentimentValue]](ctx),
+										ElementType: fwtypes.StringEnumType[awstypes.SentimentValue](),
+										Required:    true,
+									},
+								},
+								Blocks: timeRangeBlocks(ctx),
+							},
+						},
+						"transcript_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[transcriptFilterModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Validators: timeRangeValidators(),
+								Attributes: map[string]schema.Attribute{
+									"negate":           negateAttribute,
+									"participant_role": participantRoleAttribute,
+									"targets": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"transcript_filter_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TranscriptFilterType](),
+										Required:   true,
+									},
+								},
+								Blocks: timeRangeBlocks(ctx),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func timeRangeValidators() []validator.Object {
+	return []validator.Object{
+		tfobjectvalidator.AtMostOneOfChildren(
+			path.MatchRelative().AtName("absolute_time_range"),
+			path.MatchRelative().AtName("relative_time_range"),
+		),
+	}
+}
+
+func timeRangeBlocks(ctx context.Context) map[string]schema.Block {
+	return map[string]schema.Block{
+		"absolute_time_range": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[absoluteTimeRangeModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"end_time": schema.Int64Attribute{
+						Optional: true,
+					},
+					"first": schema.Int64Attribute{
+						Optional: true,
+					},
+					"last": schema.Int64Attribute{
+						Optional: true,
+					},
+					names.AttrStartTime: schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"relative_time_range": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[relativeTimeRangeModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"end_percentage": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"first": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"last": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"start_percentage": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *callAnalyticsCategoryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data callAnalyticsCategoryResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranscribeClient(ctx)
+
+	name := data.CategoryName.ValueString()
+	var input transcribe.CreateCallAnalyticsCategoryInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateCallAnalyticsCategory(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Transcribe Call Analytics Category (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	category := output.CategoryProperties
+	data.CreateTime = fwflex.TimeToFramework(ctx, category.CreateTime)
+	data.ID = fwflex.StringValueToFramework(ctx, name)
+	data.InputType = fwtypes.StringEnumValue(category.InputType)
+	data.LastUpdateTime = fwflex.TimeToFramework(ctx, category.LastUpdateTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *callAnalyticsCategoryResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data callAnalyticsCategoryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranscribeClient(ctx)
+
+	name := data.ID.ValueString()
+	output, err := findCallAnalyticsCategoryByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Transcribe Call Analytics Category (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *callAnalyticsCategoryResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new callAnalyticsCategoryResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranscribeClient(ctx)
+
+	name := new.ID.ValueString()
+	var input transcribe.UpdateCallAnalyticsCategoryInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateCallAnalyticsCategory(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Transcribe Call Analytics Category (%s)", name), err.Error())
+
+		return
+	}
+
+	new.LastUpdateTime = fwflex.TimeToFramework(ctx, output.CategoryProperties.LastUpdateTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *callAnalyticsCategoryResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data callAnalyticsCategoryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranscribeClient(ctx)
+
+	name := data.ID.ValueString()
+	input := transcribe.DeleteCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+	}
+	_, err := conn.DeleteCallAnalyticsCategory(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Transcribe Call Analytics Category (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func findCallAnalyticsCategoryByName(ctx context.Context, conn *transcribe.Client, name string) (*awstypes.CategoryProperties, error) {
+	input := transcribe.GetCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+	}
+	output, err := conn.GetCallAnalyticsCategory(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CategoryProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CategoryProperties, nil
+}
+
+type callAnalyticsCategoryResourceModel struct {
+	framework.WithRegionModel
+	CategoryName   types.String                               `tfsdk:"category_name"`
+	CreateTime     timetypes.RFC3339                          `tfsdk:"create_time"`
+	ID             types.String                               `tfsdk:"id"`
+	InputType      fwtypes.StringEnum[awstypes.InputType]     `tfsdk:"input_type"`
+	LastUpdateTime timetypes.RFC3339                          `tfsdk:"last_update_time"`
+	Rules          fwtypes.ListNestedObjectValueOf[ruleModel] `tfsdk:"rule"`
+}
+
+type ruleModel struct {
+	InterruptionFilter fwtypes.ListNestedObjectValueOf[interruptionFilterModel] `tfsdk:"interruption_filter"`
+	NonTalkTimeFilter  fwtypes.ListNestedObjectValueOf[nonTalkTimeFilterModel]  `tfsdk:"non_talk_time_filter"`
+	SentimentFilter    fwtypes.ListNestedObjectValueOf[sentimentFilterModel]    `tfsdk:"sentiment_filter"`
+	TranscriptFilter   fwtypes.ListNestedObjectValueOf[transcriptFilterModel]   `tfsdk:"transcript_filter"`
+}
+
+var (
+	_ fwflex.Expander  = ruleModel{}
+	_ fwflex.Flattener = &ruleModel{}
+)
+
+func (m ruleModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.InterruptionFilter.IsNull():
+		interruptionFilterData, d := m.InterruptionFilter.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RuleMemberInterruptionFilter
+		diags.Append(fwflex.Expand(ctx, interruptionFilterData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.NonTalkTimeFilter.IsNull():
+		nonTalkTimeFilterData, d := m.NonTalkTimeFilter.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RuleMemberNonTalkTimeFilter
+		diags.Append(fwflex.Expand(ctx, nonTalkTimeFilterData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.SentimentFilter.IsNull():
+		sentimentFilterData, d := m.SentimentFilter.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RuleMemberSentimentFilter
+		diags.Append(fwflex.Expand(ctx, sentimentFilterData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.TranscriptFilter.IsNull():
+		transcriptFilterData, d := m.TranscriptFilter.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RuleMemberTranscriptFilter
+		diags.Append(fwflex.Expand(ctx, transcriptFilterData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *ruleModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleMemberInterruptionFilter:
+		var model interruptionFilterModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.InterruptionFilter = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.RuleMemberNonTalkTimeFilter:
+		var model nonTalkTimeFilterModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.NonTalkTimeFilter = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.RuleMemberSentimentFilter:
+		var model sentimentFilterModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.SentimentFilter = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.RuleMemberTranscriptFilter:
+		var model transcriptFilterModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TranscriptFilter = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type interruptionFilterModel struct {
+	AbsoluteTimeRange fwtypes.ListNestedObjectValueOf[absoluteTimeRangeModel] `tfsdk:"absolute_time_range"`
+	Negate            types.Bool                                              `tfsdk:"negate"`
+	ParticipantRole   fwtypes.StringEnum[awstypes.ParticipantRole]            `tfsdk:"participant_role"`
+	RelativeTimeRange fwtypes.ListNestedObjectValueOf[relativeTimeRangeModel] `tfsdk:"relative_time_range"`
+	Threshold         types.Int64                                             `tfsdk:"threshold"`
+}
+
+type nonTalkTimeFilterModel struct {
+	AbsoluteTimeRange fwtypes.ListNestedObjectValueOf[absoluteTimeRangeModel] `tfsdk:"absolute_time_range"`
+	Negate            types.Bool                                              `tfsdk:"negate"`
+	RelativeTimeRange fwtypes.ListNestedObjectValueOf[relativeTimeRangeModel] `tfsdk:"relative_time_range"`
+	Threshold         types.Int64                                             `tfsdk:"threshold"`
+}
+
+type sentimentFilterModel struct {
+	AbsoluteTimeRange fwtypes.ListNestedObjectValueOf[absoluteTimeRangeModel]         `tfsdk:"absolute_time_range"`
+	Negate            types.Bool                                                      `tfsdk:"negate"`
+	ParticipantRole   fwtypes.StringEnum[awstypes.ParticipantRole]                    `tfsdk:"participant_role"`
+	RelativeTimeRange fwtypes.ListNestedObjectValueOf[relativeTimeRangeModel]         `tfsdk:"relative_time_range"`
+	Sentiments        fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.SentimentValue]] `tfsdk:"sentiments"`
+}
+
+type transcriptFilterModel struct {
+	AbsoluteTimeRange    fwtypes.ListNestedObjectValueOf[absoluteTimeRangeModel] `tfsdk:"absolute_time_range"`
+	Negate               types.Bool                                              `tfsdk:"negate"`
+	ParticipantRole      fwtypes.StringEnum[awstypes.ParticipantRole]            `tfsdk:"participant_role"`
+	RelativeTimeRange    fwtypes.ListNestedObjectValueOf[relativeTimeRangeModel] `tfsdk:"relative_time_range"`
+	Targets              fwtypes.ListOfString                                    `tfsdk:"targets"`
+	TranscriptFilterType fwtypes.StringEnum[awstypes.TranscriptFilterType]       `tfsdk:"transcript_filter_type"`
+}
+
+type absoluteTimeRangeModel struct {
+	EndTime   types.Int64 `tfsdk:"end_time"`
+	First     types.Int64 `tfsdk:"first"`
+	Last      types.Int64 `tfsdk:"last"`
+	StartTime types.Int64 `tfsdk:"start_time"`
+}
+
+type relativeTimeRangeModel struct {
+	EndPercentage   types.Int64 `tfsdk:"end_percentage"`
+	First           types.Int64 `tfsdk:"first"`
+	Last            types.Int64 `tfsdk:"last"`
+	StartPercentage types.Int64 `tfsdk:"start_percentage"`
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transcribe_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTranscribeCallAnalyticsCategory_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+			testAccCallAnalyticsCategoriesPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCallAnalyticsCategoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "category_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreateTime),
+					resource.TestCheckResourceAttr(resourceName, "input_type", string(awstypes.InputTypePostCall)),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.0.participant_role", string(awstypes.ParticipantRoleCustomer)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.sentiment_filter.0.sentiments.*", string(awstypes.SentimentValueNegative)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeCallAnalyticsCategory_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+			testAccCallAnalyticsCategoriesPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCallAnalyticsCategoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tftranscribe.ResourceCallAnalyticsCategory, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeCallAnalyticsCategory_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+			testAccCallAnalyticsCategoriesPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCallAnalyticsCategoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccCallAnalyticsCategoryConfig_multipleRules(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.interruption_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.interruption_filter.0.threshold", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.0.relative_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.transcript_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.transcript_filter.0.targets.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCallAnalyticsCategoryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_transcribe_call_analytics_category" {
+				continue
+			}
+
+			_, err := tftranscribe.FindCallAnalyticsCategoryByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Transcribe Call Analytics Category %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCallAnalyticsCategoryExists(ctx context.Context, n string, v *awstypes.CategoryProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeClient(ctx)
+
+		output, err := tftranscribe.FindCallAnalyticsCategoryByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCallAnalyticsCategoriesPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeClient(ctx)
+
+	input := &transcribe.ListCallAnalyticsCategoriesInput{}
+	_, err := conn.ListCallAnalyticsCategories(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCallAnalyticsCategoryConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+  input_type    = "POST_CALL"
+
+  rule {
+    sentiment_filter {
+      participant_role = "CUSTOMER"
+      sentiments       = ["NEGATIVE"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCallAnalyticsCategoryConfig_multipleRules(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+  input_type    = "POST_CALL"
+
+  rule {
+    interruption_filter {
+      participant_role = "AGENT"
+      threshold        = 10000
+    }
+  }
+
+  rule {
+    non_talk_time_filter {
+      threshold = 30000
+
+      relative_time_range {
+        start_percentage = 10
+        end_percentage   = 90
+      }
+    }
+  }
+
+  rule {
+    transcript_filter {
+      targets                = ["speak to a manager"]
+      transcript_filter_type = "EXACT"
+    }
+  }
+}
+`, rName)
+}
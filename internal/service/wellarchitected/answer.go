@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_answer", name="Answer")
+func newAnswerResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &answerResource{}
+
+	return r, nil
+}
+
+type answerResource struct {
+	framework.ResourceWithModel[answerResourceModel]
+	framework.WithImportByID
+}
+
+func (r *answerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"is_applicable": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"lens_alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			"pillar_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"question_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"question_title": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnswerReason](),
+				Optional:   true,
+				Computed:   true,
+			},
+			"risk": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Risk](),
+				Computed:   true,
+			},
+			"selected_choices": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *answerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data answerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewCreatingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	// Answers always exist for every question in a lens associated with a workload, so "create" is an update.
+	var input wellarchitected.UpdateAnswerInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateAnswer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Answer (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Answer, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *answerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data answerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findAnswerByThreePartKey(ctx, conn, data.WorkloadID.ValueString(), data.LensAlias.ValueString(), data.QuestionID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Answer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *answerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new answerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	var input wellarchitected.UpdateAnswerInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateAnswer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Answer (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Answer, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *answerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data answerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	// Answers cannot be deleted, so reset the question to unanswered.
+	input := wellarchitected.UpdateAnswerInput{
+		IsApplicable:    aws.Bool(true),
+		LensAlias:       fwflex.StringFromFramework(ctx, data.LensAlias),
+		Notes:           aws.String(""),
+		QuestionId:      fwflex.StringFromFramework(ctx, data.QuestionID),
+		SelectedChoices: []string{},
+		WorkloadId:      fwflex.StringFromFramework(ctx, data.WorkloadID),
+	}
+	_, err := conn.UpdateAnswer(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Answer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findAnswerByThreePartKey(ctx context.Context, conn *wellarchitected.Client, workloadID, lensAlias, questionID string) (*awstypes.Answer, error) {
+	input := wellarchitected.GetAnswerInput{
+		LensAlias:  aws.String(lensAlias),
+		QuestionId: aws.String(questionID),
+		WorkloadId: aws.String(workloadID),
+	}
+	output, err := conn.GetAnswer(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Answer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Answer, nil
+}
+
+type answerResourceModel struct {
+	framework.WithRegionModel
+	ID              types.String                              `tfsdk:"id"`
+	IsApplicable    types.Bool                                `tfsdk:"is_applicable"`
+	LensAlias       types.String                              `tfsdk:"lens_alias"`
+	Notes           types.String                              `tfsdk:"notes"`
+	PillarID        types.String                              `tfsdk:"pillar_id"`
+	QuestionID      types.String                              `tfsdk:"question_id"`
+	QuestionTitle   types.String                              `tfsdk:"question_title"`
+	Reason          fwtypes.StringEnum[awstypes.AnswerReason] `tfsdk:"reason"`
+	Risk            fwtypes.StringEnum[awstypes.Risk]         `tfsdk:"risk"`
+	SelectedChoices fwtypes.SetOfString                       `tfsdk:"selected_choices"`
+	WorkloadID      types.String                              `tfsdk:"workload_id"`
+}
+
+const (
+	answerResourceIDPartCount = 3
+)
+
+func (data *answerResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, answerResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.WorkloadID = types.StringValue(parts[0])
+	data.LensAlias = types.StringValue(parts[1])
+	data.QuestionID = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *answerResourceModel) setID() (string, error) {
+	parts := []string{
+		data.WorkloadID.ValueString(),
+		data.LensAlias.ValueString(),
+		data.QuestionID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, answerResourceIDPartCount, false)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedAnswer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Answer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_answer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnswerConfig_basic(rName, `["choice1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnswerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "is_applicable", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "notes", "Reviewed."),
+					resource.TestCheckResourceAttr(resourceName, "pillar_id", "pillar1"),
+					resource.TestCheckResourceAttr(resourceName, "question_id", "question1"),
+					resource.TestCheckResourceAttr(resourceName, "risk", "MEDIUM"),
+					resource.TestCheckResourceAttr(resourceName, "selected_choices.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "selected_choices.*", "choice1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnswerConfig_basic(rName, `["choice1", "choice2"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnswerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "risk", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "selected_choices.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnswerExists(ctx context.Context, n string, v *awstypes.Answer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindAnswerByThreePartKey(ctx, conn, rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["question_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAnswerConfig_basic(rName, selectedChoices string) string {
+	return acctest.ConfigCompose(testAccLensConfig_basic(rName, "Question 1", "1.0"), fmt.Sprintf(`
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "test"
+  environment   = "PREPRODUCTION"
+  lenses        = [aws_wellarchitected_lens.test.arn]
+  review_owner  = "owner@example.com"
+}
+
+resource "aws_wellarchitected_answer" "test" {
+  workload_id      = aws_wellarchitected_workload.test.id
+  lens_alias       = aws_wellarchitected_lens.test.arn
+  question_id      = "question1"
+  selected_choices = %[2]s
+  notes            = "Reviewed."
+}
+`, rName, selectedChoices))
+}
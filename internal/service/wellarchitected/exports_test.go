@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceAnswer    = newAnswerResource
+	ResourceLens      = newLensResource
+	ResourceLensShare = newLensShareResource
+	ResourceMilestone = newMilestoneResource
+	ResourceWorkload  = newWorkloadResource
+
+	FindAnswerByThreePartKey  = findAnswerByThreePartKey
+	FindLensByAlias           = findLensByAlias
+	FindLensShareByTwoPartKey = findLensShareByTwoPartKey
+	FindMilestoneByTwoPartKey = findMilestoneByTwoPartKey
+	FindWorkloadByID          = findWorkloadByID
+)
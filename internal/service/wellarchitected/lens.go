@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	lensPropagationTimeout = 2 * time.Minute
+)
+
+// @FrameworkResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensResource{}
+
+	return r, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithModel[lensResourceModel]
+	framework.WithImportByID
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"is_major_version": schema.BoolAttribute{
+				Optional: true,
+			},
+			"json_string": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.ImportLensInput{
+		JSONString: fwflex.StringFromFramework(ctx, data.JSONString),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.ImportLens(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Well-Architected Lens", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.LensArn)
+
+	// Lens import is asynchronous.
+	_, err = tfresource.RetryWhenNotFound(ctx, lensPropagationTimeout, func(ctx context.Context) (any, error) {
+		return findLensByAlias(ctx, conn, arn)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Well-Architected Lens (%s) import", arn), err.Error())
+
+		return
+	}
+
+	if !data.Version.IsNull() {
+		if err := publishLensVersion(ctx, conn, arn, data.Version.ValueString(), data.IsMajorVersion.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version", arn), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := findLensByAlias(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, arn)
+	data.flatten(ctx, lens)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findLensByAlias(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, output)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.ID.ValueString()
+
+	// Re-importing into an existing lens replaces its draft.
+	if !new.JSONString.Equal(old.JSONString) {
+		input := wellarchitected.ImportLensInput{
+			JSONString: fwflex.StringFromFramework(ctx, new.JSONString),
+			LensAlias:  aws.String(arn),
+		}
+
+		_, err := conn.ImportLens(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	// A new version must be published whenever the draft changes, otherwise workloads keep using the previous version.
+	if !new.Version.IsNull() && (!new.Version.Equal(old.Version) || !new.JSONString.Equal(old.JSONString)) {
+		if err := publishLensVersion(ctx, conn, arn, new.Version.ValueString(), new.IsMajorVersion.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version", arn), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := findLensByAlias(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	new.flatten(ctx, lens)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.DeleteLensInput{
+		LensAlias:  fwflex.StringFromFramework(ctx, data.ID),
+		LensStatus: awstypes.LensStatusTypeAll,
+	}
+	_, err := conn.DeleteLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func publishLensVersion(ctx context.Context, conn *wellarchitected.Client, arn, version string, isMajorVersion bool) error {
+	input := wellarchitected.CreateLensVersionInput{
+		IsMajorVersion: aws.Bool(isMajorVersion),
+		LensAlias:      aws.String(arn),
+		LensVersion:    aws.String(version),
+	}
+
+	// "ConflictException: ... import is in progress".
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.ConflictException](ctx, lensPropagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.CreateLensVersion(ctx, &input)
+	})
+
+	return err
+}
+
+func findLensByAlias(ctx context.Context, conn *wellarchitected.Client, alias string) (*awstypes.Lens, error) {
+	input := wellarchitected.GetLensInput{
+		LensAlias: aws.String(alias),
+	}
+	output, err := conn.GetLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lens == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Lens, nil
+}
+
+type lensResourceModel struct {
+	framework.WithRegionModel
+	Description    types.String         `tfsdk:"description"`
+	ID             types.String         `tfsdk:"id"`
+	IsMajorVersion types.Bool           `tfsdk:"is_major_version"`
+	JSONString     jsontypes.Normalized `tfsdk:"json_string"`
+	LensARN        types.String         `tfsdk:"arn"`
+	Name           types.String         `tfsdk:"name"`
+	Owner          types.String         `tfsdk:"owner"`
+	Tags           tftags.Map           `tfsdk:"tags"`
+	TagsAll        tftags.Map           `tfsdk:"tags_all"`
+	Version        types.String         `tfsdk:"version"`
+}
+
+func (m *lensResourceModel) flatten(ctx context.Context, lens *awstypes.Lens) {
+	m.Description = fwflex.StringToFramework(ctx, lens.Description)
+	m.LensARN = fwflex.StringToFramework(ctx, lens.LensArn)
+	m.Name = fwflex.StringToFramework(ctx, lens.Name)
+	m.Owner = fwflex.StringToFramework(ctx, lens.Owner)
+}